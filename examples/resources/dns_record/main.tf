@@ -0,0 +1,68 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "zoullx/unifi"
+    }
+  }
+}
+
+variable "UNIFI_API_KEY" {
+  type        = string
+  description = "Unifi API Key"
+  sensitive   = true
+}
+
+provider "unifi" {
+  host           = "https://10.0.0.1"
+  api_key        = var.UNIFI_API_KEY
+  allow_insecure = true
+}
+
+# Resolve the public name of the NAS to its LAN address.
+resource "unifi_dns_record" "nas" {
+  site  = "default"
+  name  = "nas.example.com"
+  type  = "A"
+  value = "10.0.10.5"
+  ttl   = 3600
+}
+
+resource "unifi_dns_record" "files" {
+  site  = "default"
+  name  = "files.example.com"
+  type  = "CNAME"
+  value = unifi_dns_record.nas.name
+}
+
+resource "unifi_dns_record" "sip" {
+  site     = "default"
+  name     = "_sip._tcp.example.com"
+  type     = "SRV"
+  value    = "pbx.example.com"
+  priority = 10
+  weight   = 5
+  port     = 5060
+}
+
+# Send queries for the lab domain to its own DNS server.
+resource "unifi_dns_record" "lab" {
+  site  = "default"
+  name  = "lab.example.com"
+  type  = "FORWARD_DOMAIN"
+  value = "10.0.50.2"
+}
+
+data "unifi_dns_records" "all" {
+  site = "default"
+
+  depends_on = [
+    unifi_dns_record.nas,
+    unifi_dns_record.files,
+    unifi_dns_record.sip,
+    unifi_dns_record.lab,
+  ]
+}
+
+output "dns_records" {
+  value = { for record in data.unifi_dns_records.all.dns_records : record.name => record.value }
+}
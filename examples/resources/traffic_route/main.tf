@@ -0,0 +1,55 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "zoullx/unifi"
+    }
+  }
+}
+
+variable "UNIFI_API_KEY" {
+  type        = string
+  description = "Unifi API Key"
+  sensitive   = true
+}
+
+variable "VPN_PASSWORD" {
+  type        = string
+  description = "Password of the VPN provider account"
+  sensitive   = true
+}
+
+provider "unifi" {
+  host           = "https://10.0.0.1"
+  api_key        = var.UNIFI_API_KEY
+  allow_insecure = true
+}
+
+resource "unifi_vpn_openvpn_client" "provider" {
+  site          = "default"
+  name          = "VPN Provider"
+  configuration = file("${path.module}/provider.ovpn")
+  username      = "customer"
+  password      = var.VPN_PASSWORD
+  default_route = false
+  pull_dns      = false
+}
+
+# Route the traffic of a single client through the VPN, and block it when
+# the VPN is down.
+resource "unifi_traffic_route" "media_player" {
+  site            = "default"
+  name            = "Media player over VPN"
+  matching_target = "internet"
+  client_macs     = ["00:11:22:aa:bb:cc"]
+  interface_id    = unifi_vpn_openvpn_client.provider.id
+  kill_switch     = true
+}
+
+# Route a few domains through the VPN for everyone.
+resource "unifi_traffic_route" "streaming" {
+  site            = "default"
+  name            = "Streaming over VPN"
+  matching_target = "domain"
+  domains         = ["bbc.co.uk"]
+  interface_id    = unifi_vpn_openvpn_client.provider.id
+}
@@ -0,0 +1,60 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "zoullx/unifi"
+    }
+  }
+}
+
+variable "UNIFI_API_KEY" {
+  type        = string
+  description = "Unifi API Key"
+  sensitive   = true
+}
+
+variable "students_network_id" {
+  type        = string
+  description = "ID of the network of the students"
+}
+
+provider "unifi" {
+  host           = "https://10.0.0.1"
+  api_key        = var.UNIFI_API_KEY
+  allow_insecure = true
+}
+
+data "unifi_dpi_apps" "catalog" {
+  site = "default"
+}
+
+locals {
+  gaming_category_ids = distinct([
+    for app in data.unifi_dpi_apps.catalog.dpi_apps : app.category_id if app.category_name == "Games"
+  ])
+}
+
+# Block games for the students during school hours.
+resource "unifi_traffic_rule" "school_hours" {
+  site             = "default"
+  name             = "No games during school hours"
+  action           = "block"
+  matching_target  = "app_category"
+  app_category_ids = local.gaming_category_ids
+  network_ids      = [var.students_network_id]
+
+  schedule_mode       = "every_week"
+  schedule_days       = ["mon", "tue", "wed", "thu", "fri"]
+  schedule_start_time = "08:00"
+  schedule_end_time   = "15:30"
+}
+
+# Limit the bandwidth of video streaming for everyone.
+resource "unifi_traffic_rule" "streaming" {
+  site            = "default"
+  name            = "Limit video streaming"
+  action          = "rate_limit"
+  matching_target = "domain"
+  domains         = ["youtube.com", "netflix.com"]
+  download_limit  = 5000
+  upload_limit    = 1000
+}
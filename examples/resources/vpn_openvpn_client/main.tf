@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "zoullx/unifi"
+    }
+  }
+}
+
+variable "UNIFI_API_KEY" {
+  type        = string
+  description = "Unifi API Key"
+  sensitive   = true
+}
+
+variable "VPN_PASSWORD" {
+  type        = string
+  description = "Password of the VPN provider account"
+  sensitive   = true
+}
+
+provider "unifi" {
+  host           = "https://10.0.0.1"
+  api_key        = var.UNIFI_API_KEY
+  allow_insecure = true
+}
+
+resource "unifi_vpn_openvpn_client" "provider" {
+  site          = "default"
+  name          = "VPN Provider"
+  configuration = file("${path.module}/provider.ovpn")
+  username      = "customer"
+  password      = var.VPN_PASSWORD
+  default_route = false
+  pull_dns      = false
+}
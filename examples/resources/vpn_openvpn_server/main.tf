@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "zoullx/unifi"
+    }
+  }
+}
+
+variable "UNIFI_API_KEY" {
+  type        = string
+  description = "Unifi API Key"
+  sensitive   = true
+}
+
+provider "unifi" {
+  host           = "https://10.0.0.1"
+  api_key        = var.UNIFI_API_KEY
+  allow_insecure = true
+}
+
+resource "unifi_vpn_openvpn_server" "remote" {
+  site     = "default"
+  name     = "Remote Access"
+  port     = 1194
+  protocol = "udp"
+  subnet   = "192.168.4.1/24"
+  dns      = ["10.1.0.1"]
+  wan      = "wan"
+}
+
+# The client configuration is exported on every run and never stored in the
+# state, e.g. to hand it to a secrets manager.
+ephemeral "unifi_vpn_openvpn_server_client_configuration" "remote" {
+  site      = "default"
+  server_id = unifi_vpn_openvpn_server.remote.id
+}
@@ -0,0 +1,54 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "zoullx/unifi"
+    }
+  }
+}
+
+variable "UNIFI_API_KEY" {
+  type        = string
+  description = "Unifi API Key"
+  sensitive   = true
+}
+
+variable "branch_psk" {
+  type        = string
+  description = "Pre-shared key of the branch tunnel"
+  sensitive   = true
+  ephemeral   = true
+}
+
+provider "unifi" {
+  host           = "https://10.0.0.1"
+  api_key        = var.UNIFI_API_KEY
+  allow_insecure = true
+}
+
+resource "unifi_vpn_site_to_site" "branch" {
+  site                      = "default"
+  name                      = "Branch 12"
+  peer_ip                   = "198.51.100.7"
+  wan                       = "wan"
+  remote_subnets            = ["10.12.0.0/16"]
+  pre_shared_key_wo         = var.branch_psk
+  pre_shared_key_wo_version = 1
+  ike_version               = "ikev2"
+  ike_encryption            = "aes256"
+  ike_hash                  = "sha256"
+  ike_dh_group              = 14
+  ike_lifetime              = 28800
+  esp_encryption            = "aes256"
+  esp_hash                  = "sha256"
+  pfs                       = true
+  esp_dh_group              = 14
+  esp_lifetime              = 3600
+}
+
+# A tunnel to another site of this controller, configured by the controller.
+resource "unifi_vpn_site_to_site" "warehouse" {
+  site           = "default"
+  name           = "Warehouse"
+  mode           = "auto"
+  remote_site_id = "5f1a2b3c4d5e6f7a8b9c0d1e"
+}
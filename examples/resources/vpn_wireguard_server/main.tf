@@ -0,0 +1,54 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "zoullx/unifi"
+    }
+  }
+}
+
+variable "UNIFI_API_KEY" {
+  type        = string
+  description = "Unifi API Key"
+  sensitive   = true
+}
+
+provider "unifi" {
+  host           = "https://10.0.0.1"
+  api_key        = var.UNIFI_API_KEY
+  allow_insecure = true
+}
+
+resource "unifi_vpn_wireguard_server" "remote" {
+  site   = "default"
+  name   = "Remote Access"
+  port   = 51820
+  subnet = "192.168.3.1/24"
+  dns    = ["10.1.0.1"]
+  wan    = "wan"
+}
+
+# A peer with a generated key pair, its configuration can be imported into
+# the WireGuard apps.
+resource "unifi_vpn_wireguard_peer" "laptop" {
+  site      = "default"
+  server_id = unifi_vpn_wireguard_server.remote.id
+  name      = "Laptop"
+  endpoint  = "vpn.example.com"
+
+  # Only route the home network through the tunnel.
+  client_allowed_ips = ["192.168.1.0/24"]
+}
+
+# A peer which keeps its private key to itself.
+resource "unifi_vpn_wireguard_peer" "phone" {
+  site         = "default"
+  server_id    = unifi_vpn_wireguard_server.remote.id
+  name         = "Phone"
+  interface_ip = "192.168.3.10"
+  public_key   = "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="
+}
+
+output "laptop_client_configuration" {
+  value     = unifi_vpn_wireguard_peer.laptop.client_configuration
+  sensitive = true
+}
@@ -0,0 +1,60 @@
+terraform {
+  required_providers {
+    unifi = {
+      source = "zoullx/unifi"
+    }
+  }
+}
+
+variable "UNIFI_API_KEY" {
+  type        = string
+  description = "Unifi API Key"
+  sensitive   = true
+}
+
+variable "PPPOE_PASSWORD" {
+  type        = string
+  description = "PPPoE password of the ISP"
+  sensitive   = true
+}
+
+provider "unifi" {
+  host           = "https://10.0.0.1"
+  api_key        = var.UNIFI_API_KEY
+  allow_insecure = true
+}
+
+resource "unifi_wan" "fiber" {
+  site                   = "default"
+  name                   = "Fiber"
+  network_group          = "WAN"
+  type                   = "pppoe"
+  username               = "customer@isp.example"
+  password               = var.PPPOE_PASSWORD
+  vlan_enabled           = true
+  vlan_id                = 7
+  dns_preference         = "manual"
+  dns                    = ["1.1.1.1", "9.9.9.9"]
+  ipv6_type              = "dhcpv6"
+  ipv6_pd_size           = 56
+  smart_queues_enabled   = true
+  smart_queues_down_rate = 500000
+  smart_queues_up_rate   = 100000
+  load_balance_type      = "failover-only"
+  failover_priority      = 1
+  connectivity_monitors  = ["1.1.1.1", "ping.ui.com"]
+}
+
+resource "unifi_wan" "lte" {
+  site              = "default"
+  name              = "LTE Backup"
+  network_group     = "WAN2"
+  type              = "dhcp"
+  load_balance_type = "failover-only"
+  failover_priority = 2
+}
+
+output "wan_fiber" {
+  value     = unifi_wan.fiber
+  sensitive = true
+}
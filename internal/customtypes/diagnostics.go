@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customtypes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// semanticEqualityTypeError is returned by the semantic equality checks when
+// the new value isn't of the same type as the prior value, which is always a
+// bug in the provider.
+func semanticEqualityTypeError(expected, got any) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Semantic Equality Check Error",
+		"An unexpected value type was received while performing semantic equality checks. "+
+			"Please report this to the provider developers.\n\n"+
+			"Expected Value Type: "+fmt.Sprintf("%T", expected)+"\n"+
+			"Got Value Type: "+fmt.Sprintf("%T", got),
+	)
+}
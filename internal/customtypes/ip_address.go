@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = IPAddressType{}
+
+// IPAddressType is a string type for IPv4 and IPv6 addresses.
+type IPAddressType struct {
+	basetypes.StringType
+}
+
+func (t IPAddressType) String() string {
+	return "customtypes.IPAddressType"
+}
+
+func (t IPAddressType) ValueType(_ context.Context) attr.Value {
+	return IPAddress{}
+}
+
+func (t IPAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(IPAddressType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t IPAddressType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPAddress{StringValue: in}, nil
+}
+
+func (t IPAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+var (
+	_ basetypes.StringValuable                   = IPAddress{}
+	_ basetypes.StringValuableWithSemanticEquals = IPAddress{}
+	_ xattr.ValidateableAttribute                = IPAddress{}
+)
+
+// IPAddress is an IP address value. Addresses are equal when they parse to
+// the same address, e.g. the compressed and expanded forms of an IPv6
+// address. An empty string is valid and means no address, as returned by the
+// controller for unset addresses.
+type IPAddress struct {
+	basetypes.StringValue
+}
+
+// NewIPAddressNull returns a null IPAddress.
+func NewIPAddressNull() IPAddress {
+	return IPAddress{StringValue: basetypes.NewStringNull()}
+}
+
+// NewIPAddressUnknown returns an unknown IPAddress.
+func NewIPAddressUnknown() IPAddress {
+	return IPAddress{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewIPAddressValue returns a known IPAddress.
+func NewIPAddressValue(value string) IPAddress {
+	return IPAddress{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v IPAddress) Type(_ context.Context) attr.Type {
+	return IPAddressType{}
+}
+
+func (v IPAddress) Equal(o attr.Value) bool {
+	other, ok := o.(IPAddress)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true when both values parse to the same
+// address, so the prior value is kept in the state.
+func (v IPAddress) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPAddress)
+	if !ok {
+		diags.Append(semanticEqualityTypeError(v, newValuable))
+		return false, diags
+	}
+
+	prior, priorErr := netip.ParseAddr(v.ValueString())
+	proposed, proposedErr := netip.ParseAddr(newValue.ValueString())
+	if priorErr != nil || proposedErr != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+
+	return prior == proposed, diags
+}
+
+func (v IPAddress) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() || v.ValueString() == "" {
+		return
+	}
+
+	if _, err := netip.ParseAddr(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			"A string value was provided that is not a valid IPv4 or IPv6 address, e.g. 192.168.1.10.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// ValueAddr returns the parsed address.
+func (v IPAddress) ValueAddr() (netip.Addr, error) {
+	return netip.ParseAddr(v.ValueString())
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = IPAddressOrPrefixType{}
+
+// IPAddressOrPrefixType is a string type for the members of a firewall group,
+// which are addresses or networks in CIDR notation for address groups, but
+// ports for port groups.
+type IPAddressOrPrefixType struct {
+	basetypes.StringType
+}
+
+func (t IPAddressOrPrefixType) String() string {
+	return "customtypes.IPAddressOrPrefixType"
+}
+
+func (t IPAddressOrPrefixType) ValueType(_ context.Context) attr.Value {
+	return IPAddressOrPrefix{}
+}
+
+func (t IPAddressOrPrefixType) Equal(o attr.Type) bool {
+	other, ok := o.(IPAddressOrPrefixType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t IPAddressOrPrefixType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPAddressOrPrefix{StringValue: in}, nil
+}
+
+func (t IPAddressOrPrefixType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+var (
+	_ basetypes.StringValuable                   = IPAddressOrPrefix{}
+	_ basetypes.StringValuableWithSemanticEquals = IPAddressOrPrefix{}
+)
+
+// IPAddressOrPrefix is an address or CIDR value. An address is equal to the
+// same address with a full-length prefix, e.g. 192.168.1.10 and
+// 192.168.1.10/32. Values that aren't addresses, e.g. ports, are compared as
+// strings and aren't validated, as the valid values depend on the type of the
+// firewall group.
+type IPAddressOrPrefix struct {
+	basetypes.StringValue
+}
+
+// NewIPAddressOrPrefixNull returns a null IPAddressOrPrefix.
+func NewIPAddressOrPrefixNull() IPAddressOrPrefix {
+	return IPAddressOrPrefix{StringValue: basetypes.NewStringNull()}
+}
+
+// NewIPAddressOrPrefixUnknown returns an unknown IPAddressOrPrefix.
+func NewIPAddressOrPrefixUnknown() IPAddressOrPrefix {
+	return IPAddressOrPrefix{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewIPAddressOrPrefixValue returns a known IPAddressOrPrefix.
+func NewIPAddressOrPrefixValue(value string) IPAddressOrPrefix {
+	return IPAddressOrPrefix{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v IPAddressOrPrefix) Type(_ context.Context) attr.Type {
+	return IPAddressOrPrefixType{}
+}
+
+func (v IPAddressOrPrefix) Equal(o attr.Value) bool {
+	other, ok := o.(IPAddressOrPrefix)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true when both values parse to the same
+// address or prefix, so the prior value is kept in the state.
+func (v IPAddressOrPrefix) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPAddressOrPrefix)
+	if !ok {
+		diags.Append(semanticEqualityTypeError(v, newValuable))
+		return false, diags
+	}
+
+	prior, priorOk := v.ValuePrefix()
+	proposed, proposedOk := newValue.ValuePrefix()
+	if !priorOk || !proposedOk {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+
+	return prior == proposed, diags
+}
+
+// ValuePrefix returns the value as a prefix, with addresses returned as
+// full-length prefixes. The second return value is false when the value
+// isn't an address or CIDR.
+func (v IPAddressOrPrefix) ValuePrefix() (netip.Prefix, bool) {
+	if prefix, err := netip.ParsePrefix(v.ValueString()); err == nil {
+		return prefix, true
+	}
+
+	addr, err := netip.ParseAddr(v.ValueString())
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), true
+}
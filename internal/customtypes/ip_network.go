@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = IPNetworkType{}
+
+// IPNetworkType is a string type for networks in CIDR notation, e.g.
+// 192.168.1.0/24.
+type IPNetworkType struct {
+	basetypes.StringType
+}
+
+func (t IPNetworkType) String() string {
+	return "customtypes.IPNetworkType"
+}
+
+func (t IPNetworkType) ValueType(_ context.Context) attr.Value {
+	return IPNetwork{}
+}
+
+func (t IPNetworkType) Equal(o attr.Type) bool {
+	other, ok := o.(IPNetworkType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t IPNetworkType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPNetwork{StringValue: in}, nil
+}
+
+func (t IPNetworkType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+var (
+	_ basetypes.StringValuable                   = IPNetwork{}
+	_ basetypes.StringValuableWithSemanticEquals = IPNetwork{}
+	_ xattr.ValidateableAttribute                = IPNetwork{}
+)
+
+// IPNetwork is a network value. Values are equal when they describe the same
+// network, i.e. the host bits of the address are ignored, so 192.168.1.1/24
+// and 192.168.1.0/24 are equal. An empty string is valid and means no
+// network.
+type IPNetwork struct {
+	basetypes.StringValue
+}
+
+// NewIPNetworkNull returns a null IPNetwork.
+func NewIPNetworkNull() IPNetwork {
+	return IPNetwork{StringValue: basetypes.NewStringNull()}
+}
+
+// NewIPNetworkUnknown returns an unknown IPNetwork.
+func NewIPNetworkUnknown() IPNetwork {
+	return IPNetwork{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewIPNetworkValue returns a known IPNetwork.
+func NewIPNetworkValue(value string) IPNetwork {
+	return IPNetwork{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v IPNetwork) Type(_ context.Context) attr.Type {
+	return IPNetworkType{}
+}
+
+func (v IPNetwork) Equal(o attr.Value) bool {
+	other, ok := o.(IPNetwork)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true when both values describe the same
+// network, so the prior value is kept in the state.
+func (v IPNetwork) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPNetwork)
+	if !ok {
+		diags.Append(semanticEqualityTypeError(v, newValuable))
+		return false, diags
+	}
+
+	prior, priorErr := netip.ParsePrefix(v.ValueString())
+	proposed, proposedErr := netip.ParsePrefix(newValue.ValueString())
+	if priorErr != nil || proposedErr != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+
+	return prior.Masked() == proposed.Masked(), diags
+}
+
+func (v IPNetwork) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() || v.ValueString() == "" {
+		return
+	}
+
+	if _, err := netip.ParsePrefix(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR Address",
+			"A string value was provided that is not a valid network in CIDR notation, e.g. 192.168.1.0/24.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// ValueNetwork returns the parsed network with the host bits of the address
+// cleared.
+func (v IPNetwork) ValueNetwork() (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(v.ValueString())
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return prefix.Masked(), nil
+}
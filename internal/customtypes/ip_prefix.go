@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = IPPrefixType{}
+
+// IPPrefixType is a string type for an address and prefix length in CIDR
+// notation, e.g. 192.168.1.1/24 for the gateway address and subnet of a
+// network.
+type IPPrefixType struct {
+	basetypes.StringType
+}
+
+func (t IPPrefixType) String() string {
+	return "customtypes.IPPrefixType"
+}
+
+func (t IPPrefixType) ValueType(_ context.Context) attr.Value {
+	return IPPrefix{}
+}
+
+func (t IPPrefixType) Equal(o attr.Type) bool {
+	other, ok := o.(IPPrefixType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t IPPrefixType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPPrefix{StringValue: in}, nil
+}
+
+func (t IPPrefixType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+var (
+	_ basetypes.StringValuable                   = IPPrefix{}
+	_ basetypes.StringValuableWithSemanticEquals = IPPrefix{}
+	_ xattr.ValidateableAttribute                = IPPrefix{}
+)
+
+// IPPrefix is an address and prefix length value. Values are equal when they
+// parse to the same address and prefix length. The address isn't masked, as
+// it is significant, e.g. 192.168.1.1/24 and 192.168.1.254/24 are networks
+// with different gateways. An empty string is valid and means no prefix.
+type IPPrefix struct {
+	basetypes.StringValue
+}
+
+// NewIPPrefixNull returns a null IPPrefix.
+func NewIPPrefixNull() IPPrefix {
+	return IPPrefix{StringValue: basetypes.NewStringNull()}
+}
+
+// NewIPPrefixUnknown returns an unknown IPPrefix.
+func NewIPPrefixUnknown() IPPrefix {
+	return IPPrefix{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewIPPrefixValue returns a known IPPrefix.
+func NewIPPrefixValue(value string) IPPrefix {
+	return IPPrefix{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v IPPrefix) Type(_ context.Context) attr.Type {
+	return IPPrefixType{}
+}
+
+func (v IPPrefix) Equal(o attr.Value) bool {
+	other, ok := o.(IPPrefix)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true when both values parse to the same
+// address and prefix length, so the prior value is kept in the state.
+func (v IPPrefix) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPPrefix)
+	if !ok {
+		diags.Append(semanticEqualityTypeError(v, newValuable))
+		return false, diags
+	}
+
+	prior, priorErr := netip.ParsePrefix(v.ValueString())
+	proposed, proposedErr := netip.ParsePrefix(newValue.ValueString())
+	if priorErr != nil || proposedErr != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+
+	return prior == proposed, diags
+}
+
+func (v IPPrefix) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() || v.ValueString() == "" {
+		return
+	}
+
+	if _, err := netip.ParsePrefix(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR Address",
+			"A string value was provided that is not a valid address in CIDR notation, e.g. 192.168.1.1/24.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// ValuePrefix returns the parsed prefix.
+func (v IPPrefix) ValuePrefix() (netip.Prefix, error) {
+	return netip.ParsePrefix(v.ValueString())
+}
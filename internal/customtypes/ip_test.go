@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPTypes_StringSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name            string
+		prior, proposed basetypes.StringValuableWithSemanticEquals
+		equal           bool
+	}{
+		{"address", NewIPAddressValue("192.168.1.10"), NewIPAddressValue("192.168.1.10"), true},
+		{"address ipv6 compressed", NewIPAddressValue("2001:db8::1"), NewIPAddressValue("2001:0db8:0000:0000:0000:0000:0000:0001"), true},
+		{"address different", NewIPAddressValue("192.168.1.10"), NewIPAddressValue("192.168.1.11"), false},
+		{"address empty", NewIPAddressValue(""), NewIPAddressValue(""), true},
+		{"prefix", NewIPPrefixValue("2001:db8::1/64"), NewIPPrefixValue("2001:0db8::0001/64"), true},
+		{"prefix host bits", NewIPPrefixValue("192.168.1.1/24"), NewIPPrefixValue("192.168.1.0/24"), false},
+		{"prefix length", NewIPPrefixValue("192.168.1.1/24"), NewIPPrefixValue("192.168.1.1/23"), false},
+		{"network host bits", NewIPNetworkValue("192.168.1.1/24"), NewIPNetworkValue("192.168.1.0/24"), true},
+		{"network different", NewIPNetworkValue("192.168.1.0/24"), NewIPNetworkValue("192.168.2.0/24"), false},
+		{"member address as prefix", NewIPAddressOrPrefixValue("192.168.1.10"), NewIPAddressOrPrefixValue("192.168.1.10/32"), true},
+		{"member ipv6", NewIPAddressOrPrefixValue("2001:db8::/32"), NewIPAddressOrPrefixValue("2001:0db8::/32"), true},
+		{"member port", NewIPAddressOrPrefixValue("8080-8090"), NewIPAddressOrPrefixValue("8080-8090"), true},
+		{"member port different", NewIPAddressOrPrefixValue("80"), NewIPAddressOrPrefixValue("443"), false},
+	} {
+		equal, diags := tc.prior.StringSemanticEquals(ctx, tc.proposed)
+		assert.False(t, diags.HasError(), tc.name)
+		assert.Equal(t, tc.equal, equal, tc.name)
+	}
+}
+
+func TestIPTypes_StringSemanticEqualsTypeMismatch(t *testing.T) {
+	_, diags := NewIPAddressValue("192.168.1.10").StringSemanticEquals(context.Background(), NewIPPrefixValue("192.168.1.10/32"))
+	assert.True(t, diags.HasError())
+}
+
+func TestIPTypes_ValidateAttribute(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		value xattr.ValidateableAttribute
+		valid bool
+	}{
+		{NewIPAddressValue("192.168.1.10"), true},
+		{NewIPAddressValue("2001:db8::1"), true},
+		{NewIPAddressValue(""), true},
+		{NewIPAddressNull(), true},
+		{NewIPAddressValue("192.168.1.256"), false},
+		{NewIPAddressValue("192.168.1.10/24"), false},
+		{NewIPPrefixValue("192.168.1.1/24"), true},
+		{NewIPPrefixUnknown(), true},
+		{NewIPPrefixValue("192.168.1.1"), false},
+		{NewIPNetworkValue("10.0.0.0/8"), true},
+		{NewIPNetworkValue("10.0.0.0/33"), false},
+	} {
+		resp := xattr.ValidateAttributeResponse{}
+		tc.value.ValidateAttribute(ctx, xattr.ValidateAttributeRequest{Path: path.Root("address")}, &resp)
+		assert.Equal(t, !tc.valid, resp.Diagnostics.HasError(), "%v", tc.value)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package customtypes contains custom attribute types for values the Unifi
+// Controller normalizes, so differently formatted but equivalent values in a
+// configuration don't cause diffs. They are referenced from
+// generate/provider-spec.json.
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = MacAddressType{}
+
+// MacAddressType is a string type for MAC addresses of six octets separated
+// by colons or hyphens, e.g. 00:11:22:AA:BB:CC or 00-11-22-aa-bb-cc.
+type MacAddressType struct {
+	basetypes.StringType
+}
+
+func (t MacAddressType) String() string {
+	return "customtypes.MacAddressType"
+}
+
+func (t MacAddressType) ValueType(_ context.Context) attr.Value {
+	return MacAddress{}
+}
+
+func (t MacAddressType) Equal(o attr.Type) bool {
+	other, ok := o.(MacAddressType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t MacAddressType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return MacAddress{StringValue: in}, nil
+}
+
+func (t MacAddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+var (
+	_ basetypes.StringValuable                   = MacAddress{}
+	_ basetypes.StringValuableWithSemanticEquals = MacAddress{}
+	_ xattr.ValidateableAttribute                = MacAddress{}
+)
+
+// MacAddress is a MAC address value. Addresses are equal when their
+// normalized forms, lowercase octets separated by colons, are equal. An empty
+// string is valid and means no address, as returned by the controller for
+// unset addresses.
+type MacAddress struct {
+	basetypes.StringValue
+}
+
+// NewMacAddressNull returns a null MacAddress.
+func NewMacAddressNull() MacAddress {
+	return MacAddress{StringValue: basetypes.NewStringNull()}
+}
+
+// NewMacAddressUnknown returns an unknown MacAddress.
+func NewMacAddressUnknown() MacAddress {
+	return MacAddress{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewMacAddressValue returns a known MacAddress.
+func NewMacAddressValue(value string) MacAddress {
+	return MacAddress{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v MacAddress) Type(_ context.Context) attr.Type {
+	return MacAddressType{}
+}
+
+func (v MacAddress) Equal(o attr.Value) bool {
+	other, ok := o.(MacAddress)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true when both addresses have the same
+// normalized form, so the prior value is kept in the state.
+func (v MacAddress) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(MacAddress)
+	if !ok {
+		diags.Append(semanticEqualityTypeError(v, newValuable))
+		return false, diags
+	}
+
+	return v.ValueNormalized() == newValue.ValueNormalized(), diags
+}
+
+func (v MacAddress) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() || v.ValueString() == "" {
+		return
+	}
+
+	if _, err := NormalizeMacAddress(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid MAC Address",
+			"A string value was provided that is not a valid MAC address, e.g. 00:11:22:aa:bb:cc.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+// ValueNormalized returns the address in lowercase with octets separated by
+// colons, the format used by the controller. Invalid addresses are returned
+// unchanged.
+func (v MacAddress) ValueNormalized() string {
+	normalized, err := NormalizeMacAddress(v.ValueString())
+	if err != nil {
+		return v.ValueString()
+	}
+
+	return normalized
+}
+
+// NormalizeMacAddress parses a MAC address of six octets separated by colons
+// or hyphens and returns it in lowercase with octets separated by colons.
+func NormalizeMacAddress(value string) (string, error) {
+	mac, err := net.ParseMAC(value)
+	if err != nil {
+		return "", err
+	}
+
+	if len(mac) != 6 || len(value) != 17 {
+		return "", fmt.Errorf("address %s: expected six octets separated by colons or hyphens", value)
+	}
+
+	return mac.String(), nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeMacAddress(t *testing.T) {
+	for _, value := range []string{"00:11:22:aa:bb:cc", "00:11:22:AA:BB:CC", "00-11-22-aa-bb-cc", "00-11-22-AA-BB-CC"} {
+		normalized, err := NormalizeMacAddress(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, "00:11:22:aa:bb:cc", normalized, value)
+	}
+
+	for _, value := range []string{"", "0011.22aa.bbcc", "00:11:22:aa:bb", "00:11:22:aa:bb:cc:dd:ee", "00:11:22:aa:bb:zz"} {
+		_, err := NormalizeMacAddress(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestMacAddress_StringSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		prior, proposed string
+		equal           bool
+	}{
+		{"00:11:22:aa:bb:cc", "00-11-22-AA-BB-CC", true},
+		{"00:11:22:aa:bb:cc", "00:11:22:aa:bb:cc", true},
+		{"00:11:22:aa:bb:cc", "00:11:22:aa:bb:cd", false},
+		{"", "", true},
+		{"invalid", "INVALID", false},
+	} {
+		equal, diags := NewMacAddressValue(tc.prior).StringSemanticEquals(ctx, NewMacAddressValue(tc.proposed))
+		assert.False(t, diags.HasError())
+		assert.Equal(t, tc.equal, equal, "%s = %s", tc.prior, tc.proposed)
+	}
+}
+
+func TestMacAddress_ValidateAttribute(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		value MacAddress
+		valid bool
+	}{
+		{NewMacAddressValue("00-11-22-AA-BB-CC"), true},
+		{NewMacAddressValue(""), true},
+		{NewMacAddressNull(), true},
+		{NewMacAddressUnknown(), true},
+		{NewMacAddressValue("00:11:22:aa:bb"), false},
+		{NewMacAddressValue("0011.22aa.bbcc"), false},
+	} {
+		resp := xattr.ValidateAttributeResponse{}
+		tc.value.ValidateAttribute(ctx, xattr.ValidateAttributeRequest{Path: path.Root("mac")}, &resp)
+		assert.Equal(t, !tc.valid, resp.Diagnostics.HasError(), tc.value.String())
+	}
+}
+
+func TestMacAddressType_ValueFromTerraform(t *testing.T) {
+	ctx := context.Background()
+
+	value, err := MacAddressType{}.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, "00:11:22:aa:bb:cc"))
+	assert.NoError(t, err)
+	assert.Equal(t, NewMacAddressValue("00:11:22:aa:bb:cc"), value)
+	assert.Equal(t, MacAddressType{}, value.Type(ctx))
+}
@@ -5,6 +5,7 @@ package datasource_ap_group
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 )
@@ -13,7 +14,7 @@ func ApGroupDataSourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"device_macs": schema.ListAttribute{
-				ElementType:         types.StringType,
+				ElementType:         customtypes.MacAddressType{},
 				Computed:            true,
 				Description:         "The MAC addresses of the APs associated with this AP Group.",
 				MarkdownDescription: "The MAC addresses of the APs associated with this AP Group.",
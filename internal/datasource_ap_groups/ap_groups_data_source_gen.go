@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -22,7 +23,7 @@ func ApGroupsDataSourceSchema(ctx context.Context) schema.Schema {
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"device_macs": schema.ListAttribute{
-							ElementType:         types.StringType,
+							ElementType:         customtypes.MacAddressType{},
 							Computed:            true,
 							Description:         "The MAC addresses of the APs associated with the AP Group.",
 							MarkdownDescription: "The MAC addresses of the APs associated with the AP Group.",
@@ -363,7 +364,7 @@ func (v ApGroupsValue) ToTerraformValue(ctx context.Context) (tftypes.Value, err
 	var err error
 
 	attrTypes["device_macs"] = basetypes.ListType{
-		ElemType: types.StringType,
+		ElemType: customtypes.MacAddressType{},
 	}.TerraformType(ctx)
 	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
@@ -430,19 +431,19 @@ func (v ApGroupsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue
 	var deviceMacsVal basetypes.ListValue
 	switch {
 	case v.DeviceMacs.IsUnknown():
-		deviceMacsVal = types.ListUnknown(types.StringType)
+		deviceMacsVal = types.ListUnknown(customtypes.MacAddressType{})
 	case v.DeviceMacs.IsNull():
-		deviceMacsVal = types.ListNull(types.StringType)
+		deviceMacsVal = types.ListNull(customtypes.MacAddressType{})
 	default:
 		var d diag.Diagnostics
-		deviceMacsVal, d = types.ListValue(types.StringType, v.DeviceMacs.Elements())
+		deviceMacsVal, d = types.ListValue(customtypes.MacAddressType{}, v.DeviceMacs.Elements())
 		diags.Append(d...)
 	}
 
 	if diags.HasError() {
 		return types.ObjectUnknown(map[string]attr.Type{
 			"device_macs": basetypes.ListType{
-				ElemType: types.StringType,
+				ElemType: customtypes.MacAddressType{},
 			},
 			"id":   basetypes.StringType{},
 			"name": basetypes.StringType{},
@@ -451,7 +452,7 @@ func (v ApGroupsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue
 
 	attributeTypes := map[string]attr.Type{
 		"device_macs": basetypes.ListType{
-			ElemType: types.StringType,
+			ElemType: customtypes.MacAddressType{},
 		},
 		"id":   basetypes.StringType{},
 		"name": basetypes.StringType{},
@@ -517,7 +518,7 @@ func (v ApGroupsValue) Type(ctx context.Context) attr.Type {
 func (v ApGroupsValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
 		"device_macs": basetypes.ListType{
-			ElemType: types.StringType,
+			ElemType: customtypes.MacAddressType{},
 		},
 		"id":   basetypes.StringType{},
 		"name": basetypes.StringType{},
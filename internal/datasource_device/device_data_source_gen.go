@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -29,6 +30,7 @@ func DeviceDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The ID of the Device to look up.",
 			},
 			"mac": schema.StringAttribute{
+				CustomType:          customtypes.MacAddressType{},
 				Computed:            true,
 				Description:         "The MAC address of the Device. This can be specified so that the provider can take control of a device (since devices are created through adoption).",
 				MarkdownDescription: "The MAC address of the Device. This can be specified so that the provider can take control of a device (since devices are created through adoption).",
@@ -92,12 +94,12 @@ func DeviceDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type DeviceModel struct {
-	Disabled      types.Bool   `tfsdk:"disabled"`
-	Id            types.String `tfsdk:"id"`
-	Mac           types.String `tfsdk:"mac"`
-	Name          types.String `tfsdk:"name"`
-	PortOverrides types.List   `tfsdk:"port_overrides"`
-	Site          types.String `tfsdk:"site"`
+	Disabled      types.Bool             `tfsdk:"disabled"`
+	Id            types.String           `tfsdk:"id"`
+	Mac           customtypes.MacAddress `tfsdk:"mac"`
+	Name          types.String           `tfsdk:"name"`
+	PortOverrides types.List             `tfsdk:"port_overrides"`
+	Site          types.String           `tfsdk:"site"`
 }
 
 var _ basetypes.ObjectTypable = PortOverridesType{}
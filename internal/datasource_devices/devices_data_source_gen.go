@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -32,6 +33,7 @@ func DevicesDataSourceSchema(ctx context.Context) schema.Schema {
 							MarkdownDescription: "The ID of the Device.",
 						},
 						"mac": schema.StringAttribute{
+							CustomType:          customtypes.MacAddressType{},
 							Computed:            true,
 							Description:         "The MAC address of the Device. This can be specified so that the provider can take control of a device (since devices are created through adoption).",
 							MarkdownDescription: "The MAC address of the Device. This can be specified so that the provider can take control of a device (since devices are created through adoption).",
@@ -181,12 +183,12 @@ func (t DevicesType) ValueFromObject(ctx context.Context, in basetypes.ObjectVal
 		return nil, diags
 	}
 
-	macVal, ok := macAttribute.(basetypes.StringValue)
+	macVal, ok := macAttribute.(customtypes.MacAddress)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`mac expected to be basetypes.StringValue, was: %T`, macAttribute))
+			fmt.Sprintf(`mac expected to be customtypes.MacAddress, was: %T`, macAttribute))
 	}
 
 	nameAttribute, ok := attributes["name"]
@@ -348,12 +350,12 @@ func NewDevicesValue(attributeTypes map[string]attr.Type, attributes map[string]
 		return NewDevicesValueUnknown(), diags
 	}
 
-	macVal, ok := macAttribute.(basetypes.StringValue)
+	macVal, ok := macAttribute.(customtypes.MacAddress)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`mac expected to be basetypes.StringValue, was: %T`, macAttribute))
+			fmt.Sprintf(`mac expected to be customtypes.MacAddress, was: %T`, macAttribute))
 	}
 
 	nameAttribute, ok := attributes["name"]
@@ -474,11 +476,11 @@ func (t DevicesType) ValueType(ctx context.Context) attr.Value {
 var _ basetypes.ObjectValuable = DevicesValue{}
 
 type DevicesValue struct {
-	Disabled      basetypes.BoolValue   `tfsdk:"disabled"`
-	Id            basetypes.StringValue `tfsdk:"id"`
-	Mac           basetypes.StringValue `tfsdk:"mac"`
-	Name          basetypes.StringValue `tfsdk:"name"`
-	PortOverrides basetypes.ListValue   `tfsdk:"port_overrides"`
+	Disabled      basetypes.BoolValue    `tfsdk:"disabled"`
+	Id            basetypes.StringValue  `tfsdk:"id"`
+	Mac           customtypes.MacAddress `tfsdk:"mac"`
+	Name          basetypes.StringValue  `tfsdk:"name"`
+	PortOverrides basetypes.ListValue    `tfsdk:"port_overrides"`
 	state         attr.ValueState
 }
 
@@ -490,7 +492,7 @@ func (v DevicesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, erro
 
 	attrTypes["disabled"] = basetypes.BoolType{}.TerraformType(ctx)
 	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["mac"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["mac"] = customtypes.MacAddressType{}.TerraformType(ctx)
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["port_overrides"] = basetypes.ListType{
 		ElemType: PortOverridesValue{}.Type(ctx),
@@ -603,7 +605,7 @@ func (v DevicesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue,
 	attributeTypes := map[string]attr.Type{
 		"disabled": basetypes.BoolType{},
 		"id":       basetypes.StringType{},
-		"mac":      basetypes.StringType{},
+		"mac":      customtypes.MacAddressType{},
 		"name":     basetypes.StringType{},
 		"port_overrides": basetypes.ListType{
 			ElemType: PortOverridesValue{}.Type(ctx),
@@ -681,7 +683,7 @@ func (v DevicesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
 		"disabled": basetypes.BoolType{},
 		"id":       basetypes.StringType{},
-		"mac":      basetypes.StringType{},
+		"mac":      customtypes.MacAddressType{},
 		"name":     basetypes.StringType{},
 		"port_overrides": basetypes.ListType{
 			ElemType: PortOverridesValue{}.Type(ctx),
@@ -5,6 +5,7 @@ package datasource_firewall_group
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 )
@@ -18,7 +19,7 @@ func FirewallGroupDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The ID of the Firewall Group to look up.",
 			},
 			"members": schema.ListAttribute{
-				ElementType:         types.StringType,
+				ElementType:         customtypes.IPAddressOrPrefixType{},
 				Computed:            true,
 				Description:         "The members of the Firewall Group.",
 				MarkdownDescription: "The members of the Firewall Group.",
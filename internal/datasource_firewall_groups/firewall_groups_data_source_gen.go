@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,7 +28,7 @@ func FirewallGroupsDataSourceSchema(ctx context.Context) schema.Schema {
 							MarkdownDescription: "The ID of the Firewall Group.",
 						},
 						"members": schema.ListAttribute{
-							ElementType:         types.StringType,
+							ElementType:         customtypes.IPAddressOrPrefixType{},
 							Computed:            true,
 							Description:         "The members of the Firewall Group.",
 							MarkdownDescription: "The members of the Firewall Group.",
@@ -452,7 +453,7 @@ func (v FirewallGroupsValue) ToTerraformValue(ctx context.Context) (tftypes.Valu
 
 	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["members"] = basetypes.ListType{
-		ElemType: types.StringType,
+		ElemType: customtypes.IPAddressOrPrefixType{},
 	}.TerraformType(ctx)
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["site_id"] = basetypes.StringType{}.TerraformType(ctx)
@@ -536,12 +537,12 @@ func (v FirewallGroupsValue) ToObjectValue(ctx context.Context) (basetypes.Objec
 	var membersVal basetypes.ListValue
 	switch {
 	case v.Members.IsUnknown():
-		membersVal = types.ListUnknown(types.StringType)
+		membersVal = types.ListUnknown(customtypes.IPAddressOrPrefixType{})
 	case v.Members.IsNull():
-		membersVal = types.ListNull(types.StringType)
+		membersVal = types.ListNull(customtypes.IPAddressOrPrefixType{})
 	default:
 		var d diag.Diagnostics
-		membersVal, d = types.ListValue(types.StringType, v.Members.Elements())
+		membersVal, d = types.ListValue(customtypes.IPAddressOrPrefixType{}, v.Members.Elements())
 		diags.Append(d...)
 	}
 
@@ -549,7 +550,7 @@ func (v FirewallGroupsValue) ToObjectValue(ctx context.Context) (basetypes.Objec
 		return types.ObjectUnknown(map[string]attr.Type{
 			"id": basetypes.StringType{},
 			"members": basetypes.ListType{
-				ElemType: types.StringType,
+				ElemType: customtypes.IPAddressOrPrefixType{},
 			},
 			"name":    basetypes.StringType{},
 			"site_id": basetypes.StringType{},
@@ -560,7 +561,7 @@ func (v FirewallGroupsValue) ToObjectValue(ctx context.Context) (basetypes.Objec
 	attributeTypes := map[string]attr.Type{
 		"id": basetypes.StringType{},
 		"members": basetypes.ListType{
-			ElemType: types.StringType,
+			ElemType: customtypes.IPAddressOrPrefixType{},
 		},
 		"name":    basetypes.StringType{},
 		"site_id": basetypes.StringType{},
@@ -638,7 +639,7 @@ func (v FirewallGroupsValue) AttributeTypes(ctx context.Context) map[string]attr
 	return map[string]attr.Type{
 		"id": basetypes.StringType{},
 		"members": basetypes.ListType{
-			ElemType: types.StringType,
+			ElemType: customtypes.IPAddressOrPrefixType{},
 		},
 		"name":    basetypes.StringType{},
 		"site_id": basetypes.StringType{},
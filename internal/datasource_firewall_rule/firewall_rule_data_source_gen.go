@@ -5,6 +5,7 @@ package datasource_firewall_rule
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 )
@@ -140,6 +141,7 @@ func FirewallRuleDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The source Firewall Group IDs for the Firewall Rule.",
 			},
 			"src_mac": schema.StringAttribute{
+				CustomType:          customtypes.MacAddressType{},
 				Computed:            true,
 				Description:         "The source MAC address of the Firewall Rule.",
 				MarkdownDescription: "The source MAC address of the Firewall Rule.",
@@ -184,37 +186,37 @@ func FirewallRuleDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type FirewallRuleModel struct {
-	Action                types.String `tfsdk:"action"`
-	DstAddress            types.String `tfsdk:"dst_address"`
-	DstAddressIpv6        types.String `tfsdk:"dst_address_ipv6"`
-	DstFirewallGroupIds   types.List   `tfsdk:"dst_firewall_group_ids"`
-	DstNetworkId          types.String `tfsdk:"dst_network_id"`
-	DstNetworkType        types.String `tfsdk:"dst_network_type"`
-	DstPort               types.String `tfsdk:"dst_port"`
-	Enabled               types.Bool   `tfsdk:"enabled"`
-	IcmpTypename          types.String `tfsdk:"icmp_typename"`
-	IcmpV6Typename        types.String `tfsdk:"icmp_v6_typename"`
-	Id                    types.String `tfsdk:"id"`
-	IpSec                 types.String `tfsdk:"ip_sec"`
-	Logging               types.Bool   `tfsdk:"logging"`
-	Name                  types.String `tfsdk:"name"`
-	Protocol              types.String `tfsdk:"protocol"`
-	ProtocolMatchExcepted types.Bool   `tfsdk:"protocol_match_excepted"`
-	ProtocolV6            types.String `tfsdk:"protocol_v6"`
-	RuleIndex             types.Int64  `tfsdk:"rule_index"`
-	Ruleset               types.String `tfsdk:"ruleset"`
-	SettingPreference     types.String `tfsdk:"setting_preference"`
-	Site                  types.String `tfsdk:"site"`
-	SiteId                types.String `tfsdk:"site_id"`
-	SrcAddress            types.String `tfsdk:"src_address"`
-	SrcAddressIpv6        types.String `tfsdk:"src_address_ipv6"`
-	SrcFirewallGroupIds   types.List   `tfsdk:"src_firewall_group_ids"`
-	SrcMac                types.String `tfsdk:"src_mac"`
-	SrcNetworkId          types.String `tfsdk:"src_network_id"`
-	SrcNetworkType        types.String `tfsdk:"src_network_type"`
-	SrcPort               types.String `tfsdk:"src_port"`
-	StateEstablished      types.Bool   `tfsdk:"state_established"`
-	StateInvalid          types.Bool   `tfsdk:"state_invalid"`
-	StateNew              types.Bool   `tfsdk:"state_new"`
-	StateRelated          types.Bool   `tfsdk:"state_related"`
+	Action                types.String           `tfsdk:"action"`
+	DstAddress            types.String           `tfsdk:"dst_address"`
+	DstAddressIpv6        types.String           `tfsdk:"dst_address_ipv6"`
+	DstFirewallGroupIds   types.List             `tfsdk:"dst_firewall_group_ids"`
+	DstNetworkId          types.String           `tfsdk:"dst_network_id"`
+	DstNetworkType        types.String           `tfsdk:"dst_network_type"`
+	DstPort               types.String           `tfsdk:"dst_port"`
+	Enabled               types.Bool             `tfsdk:"enabled"`
+	IcmpTypename          types.String           `tfsdk:"icmp_typename"`
+	IcmpV6Typename        types.String           `tfsdk:"icmp_v6_typename"`
+	Id                    types.String           `tfsdk:"id"`
+	IpSec                 types.String           `tfsdk:"ip_sec"`
+	Logging               types.Bool             `tfsdk:"logging"`
+	Name                  types.String           `tfsdk:"name"`
+	Protocol              types.String           `tfsdk:"protocol"`
+	ProtocolMatchExcepted types.Bool             `tfsdk:"protocol_match_excepted"`
+	ProtocolV6            types.String           `tfsdk:"protocol_v6"`
+	RuleIndex             types.Int64            `tfsdk:"rule_index"`
+	Ruleset               types.String           `tfsdk:"ruleset"`
+	SettingPreference     types.String           `tfsdk:"setting_preference"`
+	Site                  types.String           `tfsdk:"site"`
+	SiteId                types.String           `tfsdk:"site_id"`
+	SrcAddress            types.String           `tfsdk:"src_address"`
+	SrcAddressIpv6        types.String           `tfsdk:"src_address_ipv6"`
+	SrcFirewallGroupIds   types.List             `tfsdk:"src_firewall_group_ids"`
+	SrcMac                customtypes.MacAddress `tfsdk:"src_mac"`
+	SrcNetworkId          types.String           `tfsdk:"src_network_id"`
+	SrcNetworkType        types.String           `tfsdk:"src_network_type"`
+	SrcPort               types.String           `tfsdk:"src_port"`
+	StateEstablished      types.Bool             `tfsdk:"state_established"`
+	StateInvalid          types.Bool             `tfsdk:"state_invalid"`
+	StateNew              types.Bool             `tfsdk:"state_new"`
+	StateRelated          types.Bool             `tfsdk:"state_related"`
 }
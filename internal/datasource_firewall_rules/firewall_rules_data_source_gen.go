@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -144,6 +145,7 @@ func FirewallRulesDataSourceSchema(ctx context.Context) schema.Schema {
 							MarkdownDescription: "The source Firewall Group IDs for the Firewall Rule.",
 						},
 						"src_mac": schema.StringAttribute{
+							CustomType:          customtypes.MacAddressType{},
 							Computed:            true,
 							Description:         "The source MAC address of the Firewall Rule.",
 							MarkdownDescription: "The source MAC address of the Firewall Rule.",
@@ -675,12 +677,12 @@ func (t FirewallRulesType) ValueFromObject(ctx context.Context, in basetypes.Obj
 		return nil, diags
 	}
 
-	srcMacVal, ok := srcMacAttribute.(basetypes.StringValue)
+	srcMacVal, ok := srcMacAttribute.(customtypes.MacAddress)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`src_mac expected to be basetypes.StringValue, was: %T`, srcMacAttribute))
+			fmt.Sprintf(`src_mac expected to be customtypes.MacAddress, was: %T`, srcMacAttribute))
 	}
 
 	srcNetworkIdAttribute, ok := attributes["src_network_id"]
@@ -1355,12 +1357,12 @@ func NewFirewallRulesValue(attributeTypes map[string]attr.Type, attributes map[s
 		return NewFirewallRulesValueUnknown(), diags
 	}
 
-	srcMacVal, ok := srcMacAttribute.(basetypes.StringValue)
+	srcMacVal, ok := srcMacAttribute.(customtypes.MacAddress)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`src_mac expected to be basetypes.StringValue, was: %T`, srcMacAttribute))
+			fmt.Sprintf(`src_mac expected to be customtypes.MacAddress, was: %T`, srcMacAttribute))
 	}
 
 	srcNetworkIdAttribute, ok := attributes["src_network_id"]
@@ -1598,38 +1600,38 @@ func (t FirewallRulesType) ValueType(ctx context.Context) attr.Value {
 var _ basetypes.ObjectValuable = FirewallRulesValue{}
 
 type FirewallRulesValue struct {
-	Action                basetypes.StringValue `tfsdk:"action"`
-	DstAddress            basetypes.StringValue `tfsdk:"dst_address"`
-	DstAddressIpv6        basetypes.StringValue `tfsdk:"dst_address_ipv6"`
-	DstFirewallGroupIds   basetypes.ListValue   `tfsdk:"dst_firewall_group_ids"`
-	DstNetworkId          basetypes.StringValue `tfsdk:"dst_network_id"`
-	DstNetworkType        basetypes.StringValue `tfsdk:"dst_network_type"`
-	DstPort               basetypes.StringValue `tfsdk:"dst_port"`
-	Enabled               basetypes.BoolValue   `tfsdk:"enabled"`
-	IcmpTypename          basetypes.StringValue `tfsdk:"icmp_typename"`
-	IcmpV6Typename        basetypes.StringValue `tfsdk:"icmp_v6_typename"`
-	Id                    basetypes.StringValue `tfsdk:"id"`
-	IpSec                 basetypes.StringValue `tfsdk:"ip_sec"`
-	Logging               basetypes.BoolValue   `tfsdk:"logging"`
-	Name                  basetypes.StringValue `tfsdk:"name"`
-	Protocol              basetypes.StringValue `tfsdk:"protocol"`
-	ProtocolMatchExcepted basetypes.BoolValue   `tfsdk:"protocol_match_excepted"`
-	ProtocolV6            basetypes.StringValue `tfsdk:"protocol_v6"`
-	RuleIndex             basetypes.Int64Value  `tfsdk:"rule_index"`
-	Ruleset               basetypes.StringValue `tfsdk:"ruleset"`
-	SettingPreference     basetypes.StringValue `tfsdk:"setting_preference"`
-	SiteId                basetypes.StringValue `tfsdk:"site_id"`
-	SrcAddress            basetypes.StringValue `tfsdk:"src_address"`
-	SrcAddressIpv6        basetypes.StringValue `tfsdk:"src_address_ipv6"`
-	SrcFirewallGroupIds   basetypes.ListValue   `tfsdk:"src_firewall_group_ids"`
-	SrcMac                basetypes.StringValue `tfsdk:"src_mac"`
-	SrcNetworkId          basetypes.StringValue `tfsdk:"src_network_id"`
-	SrcNetworkType        basetypes.StringValue `tfsdk:"src_network_type"`
-	SrcPort               basetypes.StringValue `tfsdk:"src_port"`
-	StateEstablished      basetypes.BoolValue   `tfsdk:"state_established"`
-	StateInvalid          basetypes.BoolValue   `tfsdk:"state_invalid"`
-	StateNew              basetypes.BoolValue   `tfsdk:"state_new"`
-	StateRelated          basetypes.BoolValue   `tfsdk:"state_related"`
+	Action                basetypes.StringValue  `tfsdk:"action"`
+	DstAddress            basetypes.StringValue  `tfsdk:"dst_address"`
+	DstAddressIpv6        basetypes.StringValue  `tfsdk:"dst_address_ipv6"`
+	DstFirewallGroupIds   basetypes.ListValue    `tfsdk:"dst_firewall_group_ids"`
+	DstNetworkId          basetypes.StringValue  `tfsdk:"dst_network_id"`
+	DstNetworkType        basetypes.StringValue  `tfsdk:"dst_network_type"`
+	DstPort               basetypes.StringValue  `tfsdk:"dst_port"`
+	Enabled               basetypes.BoolValue    `tfsdk:"enabled"`
+	IcmpTypename          basetypes.StringValue  `tfsdk:"icmp_typename"`
+	IcmpV6Typename        basetypes.StringValue  `tfsdk:"icmp_v6_typename"`
+	Id                    basetypes.StringValue  `tfsdk:"id"`
+	IpSec                 basetypes.StringValue  `tfsdk:"ip_sec"`
+	Logging               basetypes.BoolValue    `tfsdk:"logging"`
+	Name                  basetypes.StringValue  `tfsdk:"name"`
+	Protocol              basetypes.StringValue  `tfsdk:"protocol"`
+	ProtocolMatchExcepted basetypes.BoolValue    `tfsdk:"protocol_match_excepted"`
+	ProtocolV6            basetypes.StringValue  `tfsdk:"protocol_v6"`
+	RuleIndex             basetypes.Int64Value   `tfsdk:"rule_index"`
+	Ruleset               basetypes.StringValue  `tfsdk:"ruleset"`
+	SettingPreference     basetypes.StringValue  `tfsdk:"setting_preference"`
+	SiteId                basetypes.StringValue  `tfsdk:"site_id"`
+	SrcAddress            basetypes.StringValue  `tfsdk:"src_address"`
+	SrcAddressIpv6        basetypes.StringValue  `tfsdk:"src_address_ipv6"`
+	SrcFirewallGroupIds   basetypes.ListValue    `tfsdk:"src_firewall_group_ids"`
+	SrcMac                customtypes.MacAddress `tfsdk:"src_mac"`
+	SrcNetworkId          basetypes.StringValue  `tfsdk:"src_network_id"`
+	SrcNetworkType        basetypes.StringValue  `tfsdk:"src_network_type"`
+	SrcPort               basetypes.StringValue  `tfsdk:"src_port"`
+	StateEstablished      basetypes.BoolValue    `tfsdk:"state_established"`
+	StateInvalid          basetypes.BoolValue    `tfsdk:"state_invalid"`
+	StateNew              basetypes.BoolValue    `tfsdk:"state_new"`
+	StateRelated          basetypes.BoolValue    `tfsdk:"state_related"`
 	state                 attr.ValueState
 }
 
@@ -1667,7 +1669,7 @@ func (v FirewallRulesValue) ToTerraformValue(ctx context.Context) (tftypes.Value
 	attrTypes["src_firewall_group_ids"] = basetypes.ListType{
 		ElemType: types.StringType,
 	}.TerraformType(ctx)
-	attrTypes["src_mac"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["src_mac"] = customtypes.MacAddressType{}.TerraformType(ctx)
 	attrTypes["src_network_id"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["src_network_type"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["src_port"] = basetypes.StringType{}.TerraformType(ctx)
@@ -2009,7 +2011,7 @@ func (v FirewallRulesValue) ToObjectValue(ctx context.Context) (basetypes.Object
 			"src_firewall_group_ids": basetypes.ListType{
 				ElemType: types.StringType,
 			},
-			"src_mac":           basetypes.StringType{},
+			"src_mac":           customtypes.MacAddressType{},
 			"src_network_id":    basetypes.StringType{},
 			"src_network_type":  basetypes.StringType{},
 			"src_port":          basetypes.StringType{},
@@ -2062,7 +2064,7 @@ func (v FirewallRulesValue) ToObjectValue(ctx context.Context) (basetypes.Object
 			"src_firewall_group_ids": basetypes.ListType{
 				ElemType: types.StringType,
 			},
-			"src_mac":           basetypes.StringType{},
+			"src_mac":           customtypes.MacAddressType{},
 			"src_network_id":    basetypes.StringType{},
 			"src_network_type":  basetypes.StringType{},
 			"src_port":          basetypes.StringType{},
@@ -2102,7 +2104,7 @@ func (v FirewallRulesValue) ToObjectValue(ctx context.Context) (basetypes.Object
 		"src_firewall_group_ids": basetypes.ListType{
 			ElemType: types.StringType,
 		},
-		"src_mac":           basetypes.StringType{},
+		"src_mac":           customtypes.MacAddressType{},
 		"src_network_id":    basetypes.StringType{},
 		"src_network_type":  basetypes.StringType{},
 		"src_port":          basetypes.StringType{},
@@ -2344,7 +2346,7 @@ func (v FirewallRulesValue) AttributeTypes(ctx context.Context) map[string]attr.
 		"src_firewall_group_ids": basetypes.ListType{
 			ElemType: types.StringType,
 		},
-		"src_mac":           basetypes.StringType{},
+		"src_mac":           customtypes.MacAddressType{},
 		"src_network_id":    basetypes.StringType{},
 		"src_network_type":  basetypes.StringType{},
 		"src_port":          basetypes.StringType{},
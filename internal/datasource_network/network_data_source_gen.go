@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -305,6 +306,16 @@ func NetworkDataSourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Specifies the outbound IP address pool for NAT.",
 				MarkdownDescription: "Specifies the outbound IP address pool for NAT.",
 			},
+			"netmask": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The IPv4 netmask of `subnet`, e.g. `255.255.255.0` for `192.168.1.1/24`.",
+				MarkdownDescription: "The IPv4 netmask of `subnet`, e.g. `255.255.255.0` for `192.168.1.1/24`.",
+			},
+			"network_address": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The network address of `subnet`, e.g. `192.168.1.0` for `192.168.1.1/24`.",
+				MarkdownDescription: "The network address of `subnet`, e.g. `192.168.1.0` for `192.168.1.1/24`.",
+			},
 			"network_group": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The group of the Network.",
@@ -336,6 +347,7 @@ func NetworkDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The ID of the site the Network is associated with.",
 			},
 			"subnet": schema.StringAttribute{
+				CustomType:          customtypes.IPPrefixType{},
 				Computed:            true,
 				Description:         "The subnet of the Network (CIDR address).",
 				MarkdownDescription: "The subnet of the Network (CIDR address).",
@@ -431,81 +443,83 @@ func NetworkDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type NetworkModel struct {
-	AutoScaleEnabled            types.Bool   `tfsdk:"auto_scale_enabled"`
-	DhcpBootEnabled             types.Bool   `tfsdk:"dhcp_boot_enabled"`
-	DhcpBootFilename            types.String `tfsdk:"dhcp_boot_filename"`
-	DhcpBootServer              types.String `tfsdk:"dhcp_boot_server"`
-	DhcpConflictChecking        types.Bool   `tfsdk:"dhcp_conflict_checking"`
-	DhcpDns                     types.List   `tfsdk:"dhcp_dns"`
-	DhcpDnsEnabled              types.Bool   `tfsdk:"dhcp_dns_enabled"`
-	DhcpEnabled                 types.Bool   `tfsdk:"dhcp_enabled"`
-	DhcpGatewayEnabled          types.Bool   `tfsdk:"dhcp_gateway_enabled"`
-	DhcpGuardEnabled            types.Bool   `tfsdk:"dhcp_guard_enabled"`
-	DhcpLeaseTime               types.Int64  `tfsdk:"dhcp_lease_time"`
-	DhcpNtpEnabled              types.Bool   `tfsdk:"dhcp_ntp_enabled"`
-	DhcpRelayEnabled            types.Bool   `tfsdk:"dhcp_relay_enabled"`
-	DhcpStart                   types.String `tfsdk:"dhcp_start"`
-	DhcpStop                    types.String `tfsdk:"dhcp_stop"`
-	DhcpTftpServer              types.String `tfsdk:"dhcp_tftp_server"`
-	DhcpTimeOffsetEnabled       types.Bool   `tfsdk:"dhcp_time_offset_enabled"`
-	DhcpUnifiController         types.String `tfsdk:"dhcp_unifi_controller"`
-	DhcpV6AllowSlaac            types.Bool   `tfsdk:"dhcp_v6_allow_slaac"`
-	DhcpV6Dns                   types.List   `tfsdk:"dhcp_v6_dns"`
-	DhcpV6DnsAuto               types.Bool   `tfsdk:"dhcp_v6_dns_auto"`
-	DhcpV6Enabled               types.Bool   `tfsdk:"dhcp_v6_enabled"`
-	DhcpV6LeaseTime             types.Int64  `tfsdk:"dhcp_v6_lease_time"`
-	DhcpV6Start                 types.String `tfsdk:"dhcp_v6_start"`
-	DhcpV6Stop                  types.String `tfsdk:"dhcp_v6_stop"`
-	DhcpWinsEnabled             types.Bool   `tfsdk:"dhcp_wins_enabled"`
-	DhcpWpadUrl                 types.String `tfsdk:"dhcp_wpad_url"`
-	DomainName                  types.String `tfsdk:"domain_name"`
-	Enabled                     types.Bool   `tfsdk:"enabled"`
-	GatewayType                 types.String `tfsdk:"gateway_type"`
-	Id                          types.String `tfsdk:"id"`
-	IgmpSnooping                types.Bool   `tfsdk:"igmp_snooping"`
-	InternetAccessEnabled       types.Bool   `tfsdk:"internet_access_enabled"`
-	Ipv6ClientAddressAssignment types.String `tfsdk:"ipv6_client_address_assignment"`
-	Ipv6Enabled                 types.Bool   `tfsdk:"ipv6_enabled"`
-	Ipv6InterfaceType           types.String `tfsdk:"ipv6_interface_type"`
-	Ipv6PdAutoPrefixidEnabled   types.Bool   `tfsdk:"ipv6_pd_auto_prefixid_enabled"`
-	Ipv6PdInterface             types.String `tfsdk:"ipv6_pd_interface"`
-	Ipv6PdPrefixid              types.String `tfsdk:"ipv6_pd_prefixid"`
-	Ipv6PdStart                 types.String `tfsdk:"ipv6_pd_start"`
-	Ipv6PdStop                  types.String `tfsdk:"ipv6_pd_stop"`
-	Ipv6RaEnabled               types.Bool   `tfsdk:"ipv6_ra_enabled"`
-	Ipv6RaPreferredLifetime     types.Int64  `tfsdk:"ipv6_ra_preferred_lifetime"`
-	Ipv6RaPriority              types.String `tfsdk:"ipv6_ra_priority"`
-	Ipv6RaValidLifetime         types.Int64  `tfsdk:"ipv6_ra_valid_lifetime"`
-	Ipv6SettingPreference       types.String `tfsdk:"ipv6_setting_preference"`
-	Ipv6StaticSubnet            types.String `tfsdk:"ipv6_static_subnet"`
-	LteLanEnabled               types.Bool   `tfsdk:"lte_lan_enabled"`
-	MulticastDnsEnabled         types.Bool   `tfsdk:"multicast_dns_enabled"`
-	Name                        types.String `tfsdk:"name"`
-	NatOutboundIpAddresses      types.List   `tfsdk:"nat_outbound_ip_addresses"`
-	NetworkGroup                types.String `tfsdk:"network_group"`
-	NetworkIsolationEnabled     types.Bool   `tfsdk:"network_isolation_enabled"`
-	Purpose                     types.String `tfsdk:"purpose"`
-	SettingPreference           types.String `tfsdk:"setting_preference"`
-	Site                        types.String `tfsdk:"site"`
-	SiteId                      types.String `tfsdk:"site_id"`
-	Subnet                      types.String `tfsdk:"subnet"`
-	UpnpLanEnabled              types.Bool   `tfsdk:"upnp_lan_enabled"`
-	VlanEnabled                 types.Bool   `tfsdk:"vlan_enabled"`
-	VlanId                      types.Int64  `tfsdk:"vlan_id"`
-	WanDhcpV6PdSize             types.Int64  `tfsdk:"wan_dhcp_v6_pd_size"`
-	WanDns                      types.List   `tfsdk:"wan_dns"`
-	WanEgressQos                types.Int64  `tfsdk:"wan_egress_qos"`
-	WanGateway                  types.String `tfsdk:"wan_gateway"`
-	WanGatewayV6                types.String `tfsdk:"wan_gateway_v6"`
-	WanIp                       types.String `tfsdk:"wan_ip"`
-	WanIpv6                     types.String `tfsdk:"wan_ipv6"`
-	WanNetmask                  types.String `tfsdk:"wan_netmask"`
-	WanNetworkGroup             types.String `tfsdk:"wan_network_group"`
-	WanPassword                 types.String `tfsdk:"wan_password"`
-	WanPrefixlen                types.Int64  `tfsdk:"wan_prefixlen"`
-	WanType                     types.String `tfsdk:"wan_type"`
-	WanTypeV6                   types.String `tfsdk:"wan_type_v6"`
-	WanUsername                 types.String `tfsdk:"wan_username"`
+	AutoScaleEnabled            types.Bool           `tfsdk:"auto_scale_enabled"`
+	DhcpBootEnabled             types.Bool           `tfsdk:"dhcp_boot_enabled"`
+	DhcpBootFilename            types.String         `tfsdk:"dhcp_boot_filename"`
+	DhcpBootServer              types.String         `tfsdk:"dhcp_boot_server"`
+	DhcpConflictChecking        types.Bool           `tfsdk:"dhcp_conflict_checking"`
+	DhcpDns                     types.List           `tfsdk:"dhcp_dns"`
+	DhcpDnsEnabled              types.Bool           `tfsdk:"dhcp_dns_enabled"`
+	DhcpEnabled                 types.Bool           `tfsdk:"dhcp_enabled"`
+	DhcpGatewayEnabled          types.Bool           `tfsdk:"dhcp_gateway_enabled"`
+	DhcpGuardEnabled            types.Bool           `tfsdk:"dhcp_guard_enabled"`
+	DhcpLeaseTime               types.Int64          `tfsdk:"dhcp_lease_time"`
+	DhcpNtpEnabled              types.Bool           `tfsdk:"dhcp_ntp_enabled"`
+	DhcpRelayEnabled            types.Bool           `tfsdk:"dhcp_relay_enabled"`
+	DhcpStart                   types.String         `tfsdk:"dhcp_start"`
+	DhcpStop                    types.String         `tfsdk:"dhcp_stop"`
+	DhcpTftpServer              types.String         `tfsdk:"dhcp_tftp_server"`
+	DhcpTimeOffsetEnabled       types.Bool           `tfsdk:"dhcp_time_offset_enabled"`
+	DhcpUnifiController         types.String         `tfsdk:"dhcp_unifi_controller"`
+	DhcpV6AllowSlaac            types.Bool           `tfsdk:"dhcp_v6_allow_slaac"`
+	DhcpV6Dns                   types.List           `tfsdk:"dhcp_v6_dns"`
+	DhcpV6DnsAuto               types.Bool           `tfsdk:"dhcp_v6_dns_auto"`
+	DhcpV6Enabled               types.Bool           `tfsdk:"dhcp_v6_enabled"`
+	DhcpV6LeaseTime             types.Int64          `tfsdk:"dhcp_v6_lease_time"`
+	DhcpV6Start                 types.String         `tfsdk:"dhcp_v6_start"`
+	DhcpV6Stop                  types.String         `tfsdk:"dhcp_v6_stop"`
+	DhcpWinsEnabled             types.Bool           `tfsdk:"dhcp_wins_enabled"`
+	DhcpWpadUrl                 types.String         `tfsdk:"dhcp_wpad_url"`
+	DomainName                  types.String         `tfsdk:"domain_name"`
+	Enabled                     types.Bool           `tfsdk:"enabled"`
+	GatewayType                 types.String         `tfsdk:"gateway_type"`
+	Id                          types.String         `tfsdk:"id"`
+	IgmpSnooping                types.Bool           `tfsdk:"igmp_snooping"`
+	InternetAccessEnabled       types.Bool           `tfsdk:"internet_access_enabled"`
+	Ipv6ClientAddressAssignment types.String         `tfsdk:"ipv6_client_address_assignment"`
+	Ipv6Enabled                 types.Bool           `tfsdk:"ipv6_enabled"`
+	Ipv6InterfaceType           types.String         `tfsdk:"ipv6_interface_type"`
+	Ipv6PdAutoPrefixidEnabled   types.Bool           `tfsdk:"ipv6_pd_auto_prefixid_enabled"`
+	Ipv6PdInterface             types.String         `tfsdk:"ipv6_pd_interface"`
+	Ipv6PdPrefixid              types.String         `tfsdk:"ipv6_pd_prefixid"`
+	Ipv6PdStart                 types.String         `tfsdk:"ipv6_pd_start"`
+	Ipv6PdStop                  types.String         `tfsdk:"ipv6_pd_stop"`
+	Ipv6RaEnabled               types.Bool           `tfsdk:"ipv6_ra_enabled"`
+	Ipv6RaPreferredLifetime     types.Int64          `tfsdk:"ipv6_ra_preferred_lifetime"`
+	Ipv6RaPriority              types.String         `tfsdk:"ipv6_ra_priority"`
+	Ipv6RaValidLifetime         types.Int64          `tfsdk:"ipv6_ra_valid_lifetime"`
+	Ipv6SettingPreference       types.String         `tfsdk:"ipv6_setting_preference"`
+	Ipv6StaticSubnet            types.String         `tfsdk:"ipv6_static_subnet"`
+	LteLanEnabled               types.Bool           `tfsdk:"lte_lan_enabled"`
+	MulticastDnsEnabled         types.Bool           `tfsdk:"multicast_dns_enabled"`
+	Name                        types.String         `tfsdk:"name"`
+	NatOutboundIpAddresses      types.List           `tfsdk:"nat_outbound_ip_addresses"`
+	Netmask                     types.String         `tfsdk:"netmask"`
+	NetworkAddress              types.String         `tfsdk:"network_address"`
+	NetworkGroup                types.String         `tfsdk:"network_group"`
+	NetworkIsolationEnabled     types.Bool           `tfsdk:"network_isolation_enabled"`
+	Purpose                     types.String         `tfsdk:"purpose"`
+	SettingPreference           types.String         `tfsdk:"setting_preference"`
+	Site                        types.String         `tfsdk:"site"`
+	SiteId                      types.String         `tfsdk:"site_id"`
+	Subnet                      customtypes.IPPrefix `tfsdk:"subnet"`
+	UpnpLanEnabled              types.Bool           `tfsdk:"upnp_lan_enabled"`
+	VlanEnabled                 types.Bool           `tfsdk:"vlan_enabled"`
+	VlanId                      types.Int64          `tfsdk:"vlan_id"`
+	WanDhcpV6PdSize             types.Int64          `tfsdk:"wan_dhcp_v6_pd_size"`
+	WanDns                      types.List           `tfsdk:"wan_dns"`
+	WanEgressQos                types.Int64          `tfsdk:"wan_egress_qos"`
+	WanGateway                  types.String         `tfsdk:"wan_gateway"`
+	WanGatewayV6                types.String         `tfsdk:"wan_gateway_v6"`
+	WanIp                       types.String         `tfsdk:"wan_ip"`
+	WanIpv6                     types.String         `tfsdk:"wan_ipv6"`
+	WanNetmask                  types.String         `tfsdk:"wan_netmask"`
+	WanNetworkGroup             types.String         `tfsdk:"wan_network_group"`
+	WanPassword                 types.String         `tfsdk:"wan_password"`
+	WanPrefixlen                types.Int64          `tfsdk:"wan_prefixlen"`
+	WanType                     types.String         `tfsdk:"wan_type"`
+	WanTypeV6                   types.String         `tfsdk:"wan_type_v6"`
+	WanUsername                 types.String         `tfsdk:"wan_username"`
 }
 
 var _ basetypes.ObjectTypable = NatOutboundIpAddressesType{}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -334,6 +335,7 @@ func NetworksDataSourceSchema(ctx context.Context) schema.Schema {
 							MarkdownDescription: "The ID of the site the Network is associated with.",
 						},
 						"subnet": schema.StringAttribute{
+							CustomType:          customtypes.IPPrefixType{},
 							Computed:            true,
 							Description:         "The subnet of the Network (CIDR address).",
 							MarkdownDescription: "The subnet of the Network (CIDR address).",
@@ -1492,12 +1494,12 @@ func (t NetworksType) ValueFromObject(ctx context.Context, in basetypes.ObjectVa
 		return nil, diags
 	}
 
-	subnetVal, ok := subnetAttribute.(basetypes.StringValue)
+	subnetVal, ok := subnetAttribute.(customtypes.IPPrefix)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`subnet expected to be basetypes.StringValue, was: %T`, subnetAttribute))
+			fmt.Sprintf(`subnet expected to be customtypes.IPPrefix, was: %T`, subnetAttribute))
 	}
 
 	upnpLanEnabledAttribute, ok := attributes["upnp_lan_enabled"]
@@ -2970,12 +2972,12 @@ func NewNetworksValue(attributeTypes map[string]attr.Type, attributes map[string
 		return NewNetworksValueUnknown(), diags
 	}
 
-	subnetVal, ok := subnetAttribute.(basetypes.StringValue)
+	subnetVal, ok := subnetAttribute.(customtypes.IPPrefix)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`subnet expected to be basetypes.StringValue, was: %T`, subnetAttribute))
+			fmt.Sprintf(`subnet expected to be customtypes.IPPrefix, was: %T`, subnetAttribute))
 	}
 
 	upnpLanEnabledAttribute, ok := attributes["upnp_lan_enabled"]
@@ -3491,7 +3493,7 @@ type NetworksValue struct {
 	Purpose                     basetypes.StringValue `tfsdk:"purpose"`
 	SettingPreference           basetypes.StringValue `tfsdk:"setting_preference"`
 	SiteId                      basetypes.StringValue `tfsdk:"site_id"`
-	Subnet                      basetypes.StringValue `tfsdk:"subnet"`
+	Subnet                      customtypes.IPPrefix  `tfsdk:"subnet"`
 	UpnpLanEnabled              basetypes.BoolValue   `tfsdk:"upnp_lan_enabled"`
 	VlanEnabled                 basetypes.BoolValue   `tfsdk:"vlan_enabled"`
 	VlanId                      basetypes.Int64Value  `tfsdk:"vlan_id"`
@@ -3580,7 +3582,7 @@ func (v NetworksValue) ToTerraformValue(ctx context.Context) (tftypes.Value, err
 	attrTypes["purpose"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["setting_preference"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["site_id"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["subnet"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["subnet"] = customtypes.IPPrefixType{}.TerraformType(ctx)
 	attrTypes["upnp_lan_enabled"] = basetypes.BoolType{}.TerraformType(ctx)
 	attrTypes["vlan_enabled"] = basetypes.BoolType{}.TerraformType(ctx)
 	attrTypes["vlan_id"] = basetypes.Int64Type{}.TerraformType(ctx)
@@ -4333,7 +4335,7 @@ func (v NetworksValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue
 			"purpose":                   basetypes.StringType{},
 			"setting_preference":        basetypes.StringType{},
 			"site_id":                   basetypes.StringType{},
-			"subnet":                    basetypes.StringType{},
+			"subnet":                    customtypes.IPPrefixType{},
 			"upnp_lan_enabled":          basetypes.BoolType{},
 			"vlan_enabled":              basetypes.BoolType{},
 			"vlan_id":                   basetypes.Int64Type{},
@@ -4432,7 +4434,7 @@ func (v NetworksValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue
 			"purpose":                   basetypes.StringType{},
 			"setting_preference":        basetypes.StringType{},
 			"site_id":                   basetypes.StringType{},
-			"subnet":                    basetypes.StringType{},
+			"subnet":                    customtypes.IPPrefixType{},
 			"upnp_lan_enabled":          basetypes.BoolType{},
 			"vlan_enabled":              basetypes.BoolType{},
 			"vlan_id":                   basetypes.Int64Type{},
@@ -4531,7 +4533,7 @@ func (v NetworksValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue
 			"purpose":                   basetypes.StringType{},
 			"setting_preference":        basetypes.StringType{},
 			"site_id":                   basetypes.StringType{},
-			"subnet":                    basetypes.StringType{},
+			"subnet":                    customtypes.IPPrefixType{},
 			"upnp_lan_enabled":          basetypes.BoolType{},
 			"vlan_enabled":              basetypes.BoolType{},
 			"vlan_id":                   basetypes.Int64Type{},
@@ -4617,7 +4619,7 @@ func (v NetworksValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue
 		"purpose":                   basetypes.StringType{},
 		"setting_preference":        basetypes.StringType{},
 		"site_id":                   basetypes.StringType{},
-		"subnet":                    basetypes.StringType{},
+		"subnet":                    customtypes.IPPrefixType{},
 		"upnp_lan_enabled":          basetypes.BoolType{},
 		"vlan_enabled":              basetypes.BoolType{},
 		"vlan_id":                   basetypes.Int64Type{},
@@ -5115,7 +5117,7 @@ func (v NetworksValue) AttributeTypes(ctx context.Context) map[string]attr.Type
 		"purpose":                   basetypes.StringType{},
 		"setting_preference":        basetypes.StringType{},
 		"site_id":                   basetypes.StringType{},
-		"subnet":                    basetypes.StringType{},
+		"subnet":                    customtypes.IPPrefixType{},
 		"upnp_lan_enabled":          basetypes.BoolType{},
 		"vlan_enabled":              basetypes.BoolType{},
 		"vlan_id":                   basetypes.Int64Type{},
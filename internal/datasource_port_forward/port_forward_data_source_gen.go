@@ -5,6 +5,7 @@ package datasource_port_forward
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 )
@@ -23,6 +24,7 @@ func PortForwardDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Specifies whether the Port Forward rule is enabled or not.",
 			},
 			"fwd_ip": schema.StringAttribute{
+				CustomType:          customtypes.IPAddressType{},
 				Computed:            true,
 				Description:         "The IPv4 address to forward the traffic to.",
 				MarkdownDescription: "The IPv4 address to forward the traffic to.",
@@ -79,16 +81,16 @@ func PortForwardDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type PortForwardModel struct {
-	DstPort              types.String `tfsdk:"dst_port"`
-	Enabled              types.Bool   `tfsdk:"enabled"`
-	FwdIp                types.String `tfsdk:"fwd_ip"`
-	FwdPort              types.String `tfsdk:"fwd_port"`
-	Id                   types.String `tfsdk:"id"`
-	Log                  types.Bool   `tfsdk:"log"`
-	Name                 types.String `tfsdk:"name"`
-	PortForwardInterface types.String `tfsdk:"port_forward_interface"`
-	Protocol             types.String `tfsdk:"protocol"`
-	Site                 types.String `tfsdk:"site"`
-	SiteId               types.String `tfsdk:"site_id"`
-	SrcIp                types.String `tfsdk:"src_ip"`
+	DstPort              types.String          `tfsdk:"dst_port"`
+	Enabled              types.Bool            `tfsdk:"enabled"`
+	FwdIp                customtypes.IPAddress `tfsdk:"fwd_ip"`
+	FwdPort              types.String          `tfsdk:"fwd_port"`
+	Id                   types.String          `tfsdk:"id"`
+	Log                  types.Bool            `tfsdk:"log"`
+	Name                 types.String          `tfsdk:"name"`
+	PortForwardInterface types.String          `tfsdk:"port_forward_interface"`
+	Protocol             types.String          `tfsdk:"protocol"`
+	Site                 types.String          `tfsdk:"site"`
+	SiteId               types.String          `tfsdk:"site_id"`
+	SrcIp                types.String          `tfsdk:"src_ip"`
 }
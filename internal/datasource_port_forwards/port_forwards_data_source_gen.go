@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -32,6 +33,7 @@ func PortForwardsDataSourceSchema(ctx context.Context) schema.Schema {
 							MarkdownDescription: "Specifies whether the Port Forward rule is enabled or not.",
 						},
 						"fwd_ip": schema.StringAttribute{
+							CustomType:          customtypes.IPAddressType{},
 							Computed:            true,
 							Description:         "The IPv4 address to forward the traffic to.",
 							MarkdownDescription: "The IPv4 address to forward the traffic to.",
@@ -167,12 +169,12 @@ func (t PortForwardsType) ValueFromObject(ctx context.Context, in basetypes.Obje
 		return nil, diags
 	}
 
-	fwdIpVal, ok := fwdIpAttribute.(basetypes.StringValue)
+	fwdIpVal, ok := fwdIpAttribute.(customtypes.IPAddress)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`fwd_ip expected to be basetypes.StringValue, was: %T`, fwdIpAttribute))
+			fmt.Sprintf(`fwd_ip expected to be customtypes.IPAddress, was: %T`, fwdIpAttribute))
 	}
 
 	fwdPortAttribute, ok := attributes["fwd_port"]
@@ -429,12 +431,12 @@ func NewPortForwardsValue(attributeTypes map[string]attr.Type, attributes map[st
 		return NewPortForwardsValueUnknown(), diags
 	}
 
-	fwdIpVal, ok := fwdIpAttribute.(basetypes.StringValue)
+	fwdIpVal, ok := fwdIpAttribute.(customtypes.IPAddress)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`fwd_ip expected to be basetypes.StringValue, was: %T`, fwdIpAttribute))
+			fmt.Sprintf(`fwd_ip expected to be customtypes.IPAddress, was: %T`, fwdIpAttribute))
 	}
 
 	fwdPortAttribute, ok := attributes["fwd_port"]
@@ -652,7 +654,7 @@ var _ basetypes.ObjectValuable = PortForwardsValue{}
 type PortForwardsValue struct {
 	DstPort              basetypes.StringValue `tfsdk:"dst_port"`
 	Enabled              basetypes.BoolValue   `tfsdk:"enabled"`
-	FwdIp                basetypes.StringValue `tfsdk:"fwd_ip"`
+	FwdIp                customtypes.IPAddress `tfsdk:"fwd_ip"`
 	FwdPort              basetypes.StringValue `tfsdk:"fwd_port"`
 	Id                   basetypes.StringValue `tfsdk:"id"`
 	Log                  basetypes.BoolValue   `tfsdk:"log"`
@@ -671,7 +673,7 @@ func (v PortForwardsValue) ToTerraformValue(ctx context.Context) (tftypes.Value,
 
 	attrTypes["dst_port"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["enabled"] = basetypes.BoolType{}.TerraformType(ctx)
-	attrTypes["fwd_ip"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["fwd_ip"] = customtypes.IPAddressType{}.TerraformType(ctx)
 	attrTypes["fwd_port"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["log"] = basetypes.BoolType{}.TerraformType(ctx)
@@ -798,7 +800,7 @@ func (v PortForwardsValue) ToObjectValue(ctx context.Context) (basetypes.ObjectV
 	attributeTypes := map[string]attr.Type{
 		"dst_port":               basetypes.StringType{},
 		"enabled":                basetypes.BoolType{},
-		"fwd_ip":                 basetypes.StringType{},
+		"fwd_ip":                 customtypes.IPAddressType{},
 		"fwd_port":               basetypes.StringType{},
 		"id":                     basetypes.StringType{},
 		"log":                    basetypes.BoolType{},
@@ -904,7 +906,7 @@ func (v PortForwardsValue) AttributeTypes(ctx context.Context) map[string]attr.T
 	return map[string]attr.Type{
 		"dst_port":               basetypes.StringType{},
 		"enabled":                basetypes.BoolType{},
-		"fwd_ip":                 basetypes.StringType{},
+		"fwd_ip":                 customtypes.IPAddressType{},
 		"fwd_port":               basetypes.StringType{},
 		"id":                     basetypes.StringType{},
 		"log":                    basetypes.BoolType{},
@@ -5,6 +5,7 @@ package datasource_static_route
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 )
@@ -33,6 +34,7 @@ func StaticRouteDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The name of the Static Route.",
 			},
 			"network": schema.StringAttribute{
+				CustomType:          customtypes.IPNetworkType{},
 				Computed:            true,
 				Description:         "The network subnet address.",
 				MarkdownDescription: "The network subnet address.",
@@ -57,12 +59,12 @@ func StaticRouteDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type StaticRouteModel struct {
-	Distance  types.Int64  `tfsdk:"distance"`
-	Id        types.String `tfsdk:"id"`
-	Interface types.String `tfsdk:"interface"`
-	Name      types.String `tfsdk:"name"`
-	Network   types.String `tfsdk:"network"`
-	NextHop   types.String `tfsdk:"next_hop"`
-	Site      types.String `tfsdk:"site"`
-	Type      types.String `tfsdk:"type"`
+	Distance  types.Int64           `tfsdk:"distance"`
+	Id        types.String          `tfsdk:"id"`
+	Interface types.String          `tfsdk:"interface"`
+	Name      types.String          `tfsdk:"name"`
+	Network   customtypes.IPNetwork `tfsdk:"network"`
+	NextHop   types.String          `tfsdk:"next_hop"`
+	Site      types.String          `tfsdk:"site"`
+	Type      types.String          `tfsdk:"type"`
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -47,6 +48,7 @@ func StaticRoutesDataSourceSchema(ctx context.Context) schema.Schema {
 							MarkdownDescription: "The name of the Static Route.",
 						},
 						"network": schema.StringAttribute{
+							CustomType:          customtypes.IPNetworkType{},
 							Computed:            true,
 							Description:         "The network subnet address.",
 							MarkdownDescription: "The network subnet address.",
@@ -188,12 +190,12 @@ func (t StaticRoutesType) ValueFromObject(ctx context.Context, in basetypes.Obje
 		return nil, diags
 	}
 
-	networkVal, ok := networkAttribute.(basetypes.StringValue)
+	networkVal, ok := networkAttribute.(customtypes.IPNetwork)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`network expected to be basetypes.StringValue, was: %T`, networkAttribute))
+			fmt.Sprintf(`network expected to be customtypes.IPNetwork, was: %T`, networkAttribute))
 	}
 
 	nextHopAttribute, ok := attributes["next_hop"]
@@ -393,12 +395,12 @@ func NewStaticRoutesValue(attributeTypes map[string]attr.Type, attributes map[st
 		return NewStaticRoutesValueUnknown(), diags
 	}
 
-	networkVal, ok := networkAttribute.(basetypes.StringValue)
+	networkVal, ok := networkAttribute.(customtypes.IPNetwork)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`network expected to be basetypes.StringValue, was: %T`, networkAttribute))
+			fmt.Sprintf(`network expected to be customtypes.IPNetwork, was: %T`, networkAttribute))
 	}
 
 	nextHopAttribute, ok := attributes["next_hop"]
@@ -525,7 +527,7 @@ type StaticRoutesValue struct {
 	Id               basetypes.StringValue `tfsdk:"id"`
 	Interface        basetypes.StringValue `tfsdk:"interface"`
 	Name             basetypes.StringValue `tfsdk:"name"`
-	Network          basetypes.StringValue `tfsdk:"network"`
+	Network          customtypes.IPNetwork `tfsdk:"network"`
 	NextHop          basetypes.StringValue `tfsdk:"next_hop"`
 	StaticRoutesType basetypes.StringValue `tfsdk:"type"`
 	state            attr.ValueState
@@ -541,7 +543,7 @@ func (v StaticRoutesValue) ToTerraformValue(ctx context.Context) (tftypes.Value,
 	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["interface"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["network"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["network"] = customtypes.IPNetworkType{}.TerraformType(ctx)
 	attrTypes["next_hop"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["type"] = basetypes.StringType{}.TerraformType(ctx)
 
@@ -641,7 +643,7 @@ func (v StaticRoutesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectV
 		"id":        basetypes.StringType{},
 		"interface": basetypes.StringType{},
 		"name":      basetypes.StringType{},
-		"network":   basetypes.StringType{},
+		"network":   customtypes.IPNetworkType{},
 		"next_hop":  basetypes.StringType{},
 		"type":      basetypes.StringType{},
 	}
@@ -729,7 +731,7 @@ func (v StaticRoutesValue) AttributeTypes(ctx context.Context) map[string]attr.T
 		"id":        basetypes.StringType{},
 		"interface": basetypes.StringType{},
 		"name":      basetypes.StringType{},
-		"network":   basetypes.StringType{},
+		"network":   customtypes.IPNetworkType{},
 		"next_hop":  basetypes.StringType{},
 		"type":      basetypes.StringType{},
 	}
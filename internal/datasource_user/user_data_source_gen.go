@@ -5,6 +5,7 @@ package datasource_user
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 )
@@ -23,6 +24,7 @@ func UserDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Override the device fingerprint.",
 			},
 			"fixed_ip": schema.StringAttribute{
+				CustomType:          customtypes.IPAddressType{},
 				Computed:            true,
 				Description:         "Fixed IPv4 address set for the User.",
 				MarkdownDescription: "Fixed IPv4 address set for the User.",
@@ -48,6 +50,7 @@ func UserDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The local DNS record for the User.",
 			},
 			"mac": schema.StringAttribute{
+				CustomType:          customtypes.MacAddressType{},
 				Computed:            true,
 				Description:         "The MAC address of the User.",
 				MarkdownDescription: "The MAC address of the User.",
@@ -82,17 +85,17 @@ func UserDataSourceSchema(ctx context.Context) schema.Schema {
 }
 
 type UserModel struct {
-	Blocked        types.Bool   `tfsdk:"blocked"`
-	DevIdOverride  types.Int64  `tfsdk:"dev_id_override"`
-	FixedIp        types.String `tfsdk:"fixed_ip"`
-	Hostname       types.String `tfsdk:"hostname"`
-	Id             types.String `tfsdk:"id"`
-	Ip             types.String `tfsdk:"ip"`
-	LocalDnsRecord types.String `tfsdk:"local_dns_record"`
-	Mac            types.String `tfsdk:"mac"`
-	Name           types.String `tfsdk:"name"`
-	NetworkId      types.String `tfsdk:"network_id"`
-	Note           types.String `tfsdk:"note"`
-	Site           types.String `tfsdk:"site"`
-	UserGroupId    types.String `tfsdk:"user_group_id"`
+	Blocked        types.Bool             `tfsdk:"blocked"`
+	DevIdOverride  types.Int64            `tfsdk:"dev_id_override"`
+	FixedIp        customtypes.IPAddress  `tfsdk:"fixed_ip"`
+	Hostname       types.String           `tfsdk:"hostname"`
+	Id             types.String           `tfsdk:"id"`
+	Ip             types.String           `tfsdk:"ip"`
+	LocalDnsRecord types.String           `tfsdk:"local_dns_record"`
+	Mac            customtypes.MacAddress `tfsdk:"mac"`
+	Name           types.String           `tfsdk:"name"`
+	NetworkId      types.String           `tfsdk:"network_id"`
+	Note           types.String           `tfsdk:"note"`
+	Site           types.String           `tfsdk:"site"`
+	UserGroupId    types.String           `tfsdk:"user_group_id"`
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -37,6 +38,7 @@ func UsersDataSourceSchema(ctx context.Context) schema.Schema {
 							MarkdownDescription: "Override the device fingerprint.",
 						},
 						"fixed_ip": schema.StringAttribute{
+							CustomType:          customtypes.IPAddressType{},
 							Computed:            true,
 							Description:         "Fixed IPv4 address set for the User.",
 							MarkdownDescription: "Fixed IPv4 address set for the User.",
@@ -62,6 +64,7 @@ func UsersDataSourceSchema(ctx context.Context) schema.Schema {
 							MarkdownDescription: "The local DNS record for the User.",
 						},
 						"mac": schema.StringAttribute{
+							CustomType:          customtypes.MacAddressType{},
 							Computed:            true,
 							Description:         "The MAC address of the User.",
 							MarkdownDescription: "The MAC address of the User.",
@@ -177,12 +180,12 @@ func (t UsersType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue
 		return nil, diags
 	}
 
-	fixedIpVal, ok := fixedIpAttribute.(basetypes.StringValue)
+	fixedIpVal, ok := fixedIpAttribute.(customtypes.IPAddress)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`fixed_ip expected to be basetypes.StringValue, was: %T`, fixedIpAttribute))
+			fmt.Sprintf(`fixed_ip expected to be customtypes.IPAddress, was: %T`, fixedIpAttribute))
 	}
 
 	hostnameAttribute, ok := attributes["hostname"]
@@ -267,12 +270,12 @@ func (t UsersType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue
 		return nil, diags
 	}
 
-	macVal, ok := macAttribute.(basetypes.StringValue)
+	macVal, ok := macAttribute.(customtypes.MacAddress)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`mac expected to be basetypes.StringValue, was: %T`, macAttribute))
+			fmt.Sprintf(`mac expected to be customtypes.MacAddress, was: %T`, macAttribute))
 	}
 
 	nameAttribute, ok := attributes["name"]
@@ -477,12 +480,12 @@ func NewUsersValue(attributeTypes map[string]attr.Type, attributes map[string]at
 		return NewUsersValueUnknown(), diags
 	}
 
-	fixedIpVal, ok := fixedIpAttribute.(basetypes.StringValue)
+	fixedIpVal, ok := fixedIpAttribute.(customtypes.IPAddress)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`fixed_ip expected to be basetypes.StringValue, was: %T`, fixedIpAttribute))
+			fmt.Sprintf(`fixed_ip expected to be customtypes.IPAddress, was: %T`, fixedIpAttribute))
 	}
 
 	hostnameAttribute, ok := attributes["hostname"]
@@ -567,12 +570,12 @@ func NewUsersValue(attributeTypes map[string]attr.Type, attributes map[string]at
 		return NewUsersValueUnknown(), diags
 	}
 
-	macVal, ok := macAttribute.(basetypes.StringValue)
+	macVal, ok := macAttribute.(customtypes.MacAddress)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`mac expected to be basetypes.StringValue, was: %T`, macAttribute))
+			fmt.Sprintf(`mac expected to be customtypes.MacAddress, was: %T`, macAttribute))
 	}
 
 	nameAttribute, ok := attributes["name"]
@@ -736,18 +739,18 @@ func (t UsersType) ValueType(ctx context.Context) attr.Value {
 var _ basetypes.ObjectValuable = UsersValue{}
 
 type UsersValue struct {
-	Blocked        basetypes.BoolValue   `tfsdk:"blocked"`
-	DevIdOverride  basetypes.Int64Value  `tfsdk:"dev_id_override"`
-	FixedIp        basetypes.StringValue `tfsdk:"fixed_ip"`
-	Hostname       basetypes.StringValue `tfsdk:"hostname"`
-	Id             basetypes.StringValue `tfsdk:"id"`
-	Ip             basetypes.StringValue `tfsdk:"ip"`
-	LocalDnsRecord basetypes.StringValue `tfsdk:"local_dns_record"`
-	Mac            basetypes.StringValue `tfsdk:"mac"`
-	Name           basetypes.StringValue `tfsdk:"name"`
-	NetworkId      basetypes.StringValue `tfsdk:"network_id"`
-	Note           basetypes.StringValue `tfsdk:"note"`
-	UserGroupId    basetypes.StringValue `tfsdk:"user_group_id"`
+	Blocked        basetypes.BoolValue    `tfsdk:"blocked"`
+	DevIdOverride  basetypes.Int64Value   `tfsdk:"dev_id_override"`
+	FixedIp        customtypes.IPAddress  `tfsdk:"fixed_ip"`
+	Hostname       basetypes.StringValue  `tfsdk:"hostname"`
+	Id             basetypes.StringValue  `tfsdk:"id"`
+	Ip             basetypes.StringValue  `tfsdk:"ip"`
+	LocalDnsRecord basetypes.StringValue  `tfsdk:"local_dns_record"`
+	Mac            customtypes.MacAddress `tfsdk:"mac"`
+	Name           basetypes.StringValue  `tfsdk:"name"`
+	NetworkId      basetypes.StringValue  `tfsdk:"network_id"`
+	Note           basetypes.StringValue  `tfsdk:"note"`
+	UserGroupId    basetypes.StringValue  `tfsdk:"user_group_id"`
 	state          attr.ValueState
 }
 
@@ -759,12 +762,12 @@ func (v UsersValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error)
 
 	attrTypes["blocked"] = basetypes.BoolType{}.TerraformType(ctx)
 	attrTypes["dev_id_override"] = basetypes.Int64Type{}.TerraformType(ctx)
-	attrTypes["fixed_ip"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["fixed_ip"] = customtypes.IPAddressType{}.TerraformType(ctx)
 	attrTypes["hostname"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["ip"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["local_dns_record"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["mac"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["mac"] = customtypes.MacAddressType{}.TerraformType(ctx)
 	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["network_id"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["note"] = basetypes.StringType{}.TerraformType(ctx)
@@ -904,12 +907,12 @@ func (v UsersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 	attributeTypes := map[string]attr.Type{
 		"blocked":          basetypes.BoolType{},
 		"dev_id_override":  basetypes.Int64Type{},
-		"fixed_ip":         basetypes.StringType{},
+		"fixed_ip":         customtypes.IPAddressType{},
 		"hostname":         basetypes.StringType{},
 		"id":               basetypes.StringType{},
 		"ip":               basetypes.StringType{},
 		"local_dns_record": basetypes.StringType{},
-		"mac":              basetypes.StringType{},
+		"mac":              customtypes.MacAddressType{},
 		"name":             basetypes.StringType{},
 		"network_id":       basetypes.StringType{},
 		"note":             basetypes.StringType{},
@@ -1022,12 +1025,12 @@ func (v UsersValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 	return map[string]attr.Type{
 		"blocked":          basetypes.BoolType{},
 		"dev_id_override":  basetypes.Int64Type{},
-		"fixed_ip":         basetypes.StringType{},
+		"fixed_ip":         customtypes.IPAddressType{},
 		"hostname":         basetypes.StringType{},
 		"id":               basetypes.StringType{},
 		"ip":               basetypes.StringType{},
 		"local_dns_record": basetypes.StringType{},
-		"mac":              basetypes.StringType{},
+		"mac":              customtypes.MacAddressType{},
 		"name":             basetypes.StringType{},
 		"network_id":       basetypes.StringType{},
 		"note":             basetypes.StringType{},
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -126,7 +127,7 @@ func WlanDataSourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Indicates whether or not the MAC filter is turned on for the network.",
 			},
 			"mac_filter_list": schema.ListAttribute{
-				ElementType:         types.StringType,
+				ElementType:         customtypes.MacAddressType{},
 				Computed:            true,
 				Description:         "List of MAC addresses to filter (only valid if `mac_filter_enabled` is `true`).",
 				MarkdownDescription: "List of MAC addresses to filter (only valid if `mac_filter_enabled` is `true`).",
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -63,7 +64,7 @@ func WlansDataSourceSchema(ctx context.Context) schema.Schema {
 							Description:         "TODO: Figure out what this is.",
 							MarkdownDescription: "TODO: Figure out what this is.",
 						},
-						"dtim_6e": schema.BoolAttribute{
+						"dtim_6e": schema.Int64Attribute{
 							Computed:            true,
 							Description:         "TODO: Figure out what this is.",
 							MarkdownDescription: "TODO: Figure out what this is.",
@@ -134,7 +135,7 @@ func WlansDataSourceSchema(ctx context.Context) schema.Schema {
 							MarkdownDescription: "Indicates whether or not the MAC filter is turned on for the network.",
 						},
 						"mac_filter_list": schema.ListAttribute{
-							ElementType:         types.StringType,
+							ElementType:         customtypes.MacAddressType{},
 							Computed:            true,
 							Description:         "List of MAC addresses to filter (only valid if `mac_filter_enabled` is `true`).",
 							MarkdownDescription: "List of MAC addresses to filter (only valid if `mac_filter_enabled` is `true`).",
@@ -604,12 +605,12 @@ func (t WlansType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue
 		return nil, diags
 	}
 
-	dtim6eVal, ok := dtim6eAttribute.(basetypes.BoolValue)
+	dtim6eVal, ok := dtim6eAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`dtim_6e expected to be basetypes.BoolValue, was: %T`, dtim6eAttribute))
+			fmt.Sprintf(`dtim_6e expected to be basetypes.Int64Value, was: %T`, dtim6eAttribute))
 	}
 
 	dtimModeAttribute, ok := attributes["dtim_mode"]
@@ -1854,12 +1855,12 @@ func NewWlansValue(attributeTypes map[string]attr.Type, attributes map[string]at
 		return NewWlansValueUnknown(), diags
 	}
 
-	dtim6eVal, ok := dtim6eAttribute.(basetypes.BoolValue)
+	dtim6eVal, ok := dtim6eAttribute.(basetypes.Int64Value)
 
 	if !ok {
 		diags.AddError(
 			"Attribute Wrong Type",
-			fmt.Sprintf(`dtim_6e expected to be basetypes.BoolValue, was: %T`, dtim6eAttribute))
+			fmt.Sprintf(`dtim_6e expected to be basetypes.Int64Value, was: %T`, dtim6eAttribute))
 	}
 
 	dtimModeAttribute, ok := attributes["dtim_mode"]
@@ -2980,7 +2981,7 @@ type WlansValue struct {
 	BssTransition                    basetypes.BoolValue   `tfsdk:"bss_transition"`
 	Dtim2g                           basetypes.Int64Value  `tfsdk:"dtim_2g"`
 	Dtim5g                           basetypes.Int64Value  `tfsdk:"dtim_5g"`
-	Dtim6e                           basetypes.BoolValue   `tfsdk:"dtim_6e"`
+	Dtim6e                           basetypes.Int64Value  `tfsdk:"dtim_6e"`
 	DtimMode                         basetypes.StringValue `tfsdk:"dtim_mode"`
 	Enabled                          basetypes.BoolValue   `tfsdk:"enabled"`
 	EnhancedIot                      basetypes.BoolValue   `tfsdk:"enhanced_iot"`
@@ -3055,7 +3056,7 @@ func (v WlansValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error)
 	attrTypes["bss_transition"] = basetypes.BoolType{}.TerraformType(ctx)
 	attrTypes["dtim_2g"] = basetypes.Int64Type{}.TerraformType(ctx)
 	attrTypes["dtim_5g"] = basetypes.Int64Type{}.TerraformType(ctx)
-	attrTypes["dtim_6e"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["dtim_6e"] = basetypes.Int64Type{}.TerraformType(ctx)
 	attrTypes["dtim_mode"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["enabled"] = basetypes.BoolType{}.TerraformType(ctx)
 	attrTypes["enhanced_iot"] = basetypes.BoolType{}.TerraformType(ctx)
@@ -3070,7 +3071,7 @@ func (v WlansValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error)
 	attrTypes["l2_isolation"] = basetypes.BoolType{}.TerraformType(ctx)
 	attrTypes["mac_filter_enabled"] = basetypes.BoolType{}.TerraformType(ctx)
 	attrTypes["mac_filter_list"] = basetypes.ListType{
-		ElemType: types.StringType,
+		ElemType: customtypes.MacAddressType{},
 	}.TerraformType(ctx)
 	attrTypes["mac_filter_policy"] = basetypes.StringType{}.TerraformType(ctx)
 	attrTypes["minimum_2g_advertising_rates"] = basetypes.BoolType{}.TerraformType(ctx)
@@ -3766,7 +3767,7 @@ func (v WlansValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 			"bss_transition":       basetypes.BoolType{},
 			"dtim_2g":              basetypes.Int64Type{},
 			"dtim_5g":              basetypes.Int64Type{},
-			"dtim_6e":              basetypes.BoolType{},
+			"dtim_6e":              basetypes.Int64Type{},
 			"dtim_mode":            basetypes.StringType{},
 			"enabled":              basetypes.BoolType{},
 			"enhanced_iot":         basetypes.BoolType{},
@@ -3781,7 +3782,7 @@ func (v WlansValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 			"l2_isolation":         basetypes.BoolType{},
 			"mac_filter_enabled":   basetypes.BoolType{},
 			"mac_filter_list": basetypes.ListType{
-				ElemType: types.StringType,
+				ElemType: customtypes.MacAddressType{},
 			},
 			"mac_filter_policy":                    basetypes.StringType{},
 			"minimum_2g_advertising_rates":         basetypes.BoolType{},
@@ -3861,7 +3862,7 @@ func (v WlansValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 			"bss_transition":       basetypes.BoolType{},
 			"dtim_2g":              basetypes.Int64Type{},
 			"dtim_5g":              basetypes.Int64Type{},
-			"dtim_6e":              basetypes.BoolType{},
+			"dtim_6e":              basetypes.Int64Type{},
 			"dtim_mode":            basetypes.StringType{},
 			"enabled":              basetypes.BoolType{},
 			"enhanced_iot":         basetypes.BoolType{},
@@ -3876,7 +3877,7 @@ func (v WlansValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 			"l2_isolation":         basetypes.BoolType{},
 			"mac_filter_enabled":   basetypes.BoolType{},
 			"mac_filter_list": basetypes.ListType{
-				ElemType: types.StringType,
+				ElemType: customtypes.MacAddressType{},
 			},
 			"mac_filter_policy":                    basetypes.StringType{},
 			"minimum_2g_advertising_rates":         basetypes.BoolType{},
@@ -3934,12 +3935,12 @@ func (v WlansValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 	var macFilterListVal basetypes.ListValue
 	switch {
 	case v.MacFilterList.IsUnknown():
-		macFilterListVal = types.ListUnknown(types.StringType)
+		macFilterListVal = types.ListUnknown(customtypes.MacAddressType{})
 	case v.MacFilterList.IsNull():
-		macFilterListVal = types.ListNull(types.StringType)
+		macFilterListVal = types.ListNull(customtypes.MacAddressType{})
 	default:
 		var d diag.Diagnostics
-		macFilterListVal, d = types.ListValue(types.StringType, v.MacFilterList.Elements())
+		macFilterListVal, d = types.ListValue(customtypes.MacAddressType{}, v.MacFilterList.Elements())
 		diags.Append(d...)
 	}
 
@@ -3956,7 +3957,7 @@ func (v WlansValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 			"bss_transition":       basetypes.BoolType{},
 			"dtim_2g":              basetypes.Int64Type{},
 			"dtim_5g":              basetypes.Int64Type{},
-			"dtim_6e":              basetypes.BoolType{},
+			"dtim_6e":              basetypes.Int64Type{},
 			"dtim_mode":            basetypes.StringType{},
 			"enabled":              basetypes.BoolType{},
 			"enhanced_iot":         basetypes.BoolType{},
@@ -3971,7 +3972,7 @@ func (v WlansValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 			"l2_isolation":         basetypes.BoolType{},
 			"mac_filter_enabled":   basetypes.BoolType{},
 			"mac_filter_list": basetypes.ListType{
-				ElemType: types.StringType,
+				ElemType: customtypes.MacAddressType{},
 			},
 			"mac_filter_policy":                    basetypes.StringType{},
 			"minimum_2g_advertising_rates":         basetypes.BoolType{},
@@ -4051,7 +4052,7 @@ func (v WlansValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 			"bss_transition":       basetypes.BoolType{},
 			"dtim_2g":              basetypes.Int64Type{},
 			"dtim_5g":              basetypes.Int64Type{},
-			"dtim_6e":              basetypes.BoolType{},
+			"dtim_6e":              basetypes.Int64Type{},
 			"dtim_mode":            basetypes.StringType{},
 			"enabled":              basetypes.BoolType{},
 			"enhanced_iot":         basetypes.BoolType{},
@@ -4066,7 +4067,7 @@ func (v WlansValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 			"l2_isolation":         basetypes.BoolType{},
 			"mac_filter_enabled":   basetypes.BoolType{},
 			"mac_filter_list": basetypes.ListType{
-				ElemType: types.StringType,
+				ElemType: customtypes.MacAddressType{},
 			},
 			"mac_filter_policy":                    basetypes.StringType{},
 			"minimum_2g_advertising_rates":         basetypes.BoolType{},
@@ -4146,7 +4147,7 @@ func (v WlansValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 			"bss_transition":       basetypes.BoolType{},
 			"dtim_2g":              basetypes.Int64Type{},
 			"dtim_5g":              basetypes.Int64Type{},
-			"dtim_6e":              basetypes.BoolType{},
+			"dtim_6e":              basetypes.Int64Type{},
 			"dtim_mode":            basetypes.StringType{},
 			"enabled":              basetypes.BoolType{},
 			"enhanced_iot":         basetypes.BoolType{},
@@ -4161,7 +4162,7 @@ func (v WlansValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 			"l2_isolation":         basetypes.BoolType{},
 			"mac_filter_enabled":   basetypes.BoolType{},
 			"mac_filter_list": basetypes.ListType{
-				ElemType: types.StringType,
+				ElemType: customtypes.MacAddressType{},
 			},
 			"mac_filter_policy":                    basetypes.StringType{},
 			"minimum_2g_advertising_rates":         basetypes.BoolType{},
@@ -4228,7 +4229,7 @@ func (v WlansValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 		"bss_transition":       basetypes.BoolType{},
 		"dtim_2g":              basetypes.Int64Type{},
 		"dtim_5g":              basetypes.Int64Type{},
-		"dtim_6e":              basetypes.BoolType{},
+		"dtim_6e":              basetypes.Int64Type{},
 		"dtim_mode":            basetypes.StringType{},
 		"enabled":              basetypes.BoolType{},
 		"enhanced_iot":         basetypes.BoolType{},
@@ -4243,7 +4244,7 @@ func (v WlansValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, d
 		"l2_isolation":         basetypes.BoolType{},
 		"mac_filter_enabled":   basetypes.BoolType{},
 		"mac_filter_list": basetypes.ListType{
-			ElemType: types.StringType,
+			ElemType: customtypes.MacAddressType{},
 		},
 		"mac_filter_policy":                    basetypes.StringType{},
 		"minimum_2g_advertising_rates":         basetypes.BoolType{},
@@ -4662,7 +4663,7 @@ func (v WlansValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 		"bss_transition":       basetypes.BoolType{},
 		"dtim_2g":              basetypes.Int64Type{},
 		"dtim_5g":              basetypes.Int64Type{},
-		"dtim_6e":              basetypes.BoolType{},
+		"dtim_6e":              basetypes.Int64Type{},
 		"dtim_mode":            basetypes.StringType{},
 		"enabled":              basetypes.BoolType{},
 		"enhanced_iot":         basetypes.BoolType{},
@@ -4677,7 +4678,7 @@ func (v WlansValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
 		"l2_isolation":         basetypes.BoolType{},
 		"mac_filter_enabled":   basetypes.BoolType{},
 		"mac_filter_list": basetypes.ListType{
-			ElemType: types.StringType,
+			ElemType: customtypes.MacAddressType{},
 		},
 		"mac_filter_policy":                    basetypes.StringType{},
 		"minimum_2g_advertising_rates":         basetypes.BoolType{},
@@ -10,7 +10,6 @@ import (
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_account"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -73,17 +72,8 @@ func (d *accountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	parseAccountDataSourceJson(*account, &data)
+	mapAccountDataSourceJson(*account, &data)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-func parseAccountDataSourceJson(json unifi.Account, model *datasource_account.AccountModel) {
-	model.Id = types.StringValue(json.ID)
-	model.Name = types.StringValue(json.Name)
-	model.Password = types.StringValue(json.XPassword)
-	model.TunnelType = types.Int64Value(int64(json.TunnelType))
-	model.TunnelMediumType = types.Int64Value(int64(json.TunnelMediumType))
-	model.NetworkId = types.StringValue(json.NetworkID)
-}
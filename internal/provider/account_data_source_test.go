@@ -33,7 +33,7 @@ func TestAccountDataSource_Read_Success(t *testing.T) {
 		NetworkID:        "net-456",
 	}
 	model := &datasource_account.AccountModel{}
-	parseAccountDataSourceJson(account, model)
+	mapAccountDataSourceJson(account, model)
 
 	assert.Equal(t, "acc-123", model.Id.ValueString())
 	assert.Equal(t, "test-account", model.Name.ValueString())
@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/zoullx/terraform-provider-unifi/internal/resource_account"
@@ -61,9 +60,8 @@ func (r *accountResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 func (r *accountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
 			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
@@ -71,11 +69,16 @@ func (r *accountResource) ImportState(ctx context.Context, req resource.ImportSt
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (r *accountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create Account")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_account.AccountModel
 
 	// Read Terraform plan data into the model
@@ -85,7 +88,7 @@ func (r *accountResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	var body unifi.Account
-	parseAccountResourceModel(data, &body)
+	mapAccountResourceModel(data, &body)
 	account, err := r.client.CreateAccount(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -95,7 +98,7 @@ func (r *accountResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	parseAccountResourceJson(*account, &data)
+	mapAccountResourceJson(*account, &data)
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	// Save data into Terraform state
@@ -121,13 +124,18 @@ func (r *accountResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	parseAccountResourceJson(*account, &data)
+	mapAccountResourceJson(*account, &data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *accountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update Account")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_account.AccountModel
 
 	// Read Terraform plan data into the model
@@ -136,8 +144,16 @@ func (r *accountResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	var body unifi.Account
-	parseAccountResourceModel(data, &body)
+	current, diags := readForUpdate(ctx, r.client, nil, "Account", func() (*unifi.Account, error) {
+		return r.client.GetAccount(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	mapAccountResourceModel(data, &body)
 	account, err := r.client.UpdateAccount(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -147,7 +163,7 @@ func (r *accountResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	parseAccountResourceJson(*account, &data)
+	mapAccountResourceJson(*account, &data)
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	// Save updated data into Terraform state
@@ -155,6 +171,11 @@ func (r *accountResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *accountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete Account")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_account.AccountModel
 
 	// Read Terraform prior state data into the model
@@ -173,21 +194,3 @@ func (r *accountResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 }
-
-func parseAccountResourceJson(json unifi.Account, model *resource_account.AccountModel) {
-	model.Id = types.StringValue(json.ID)
-	model.Name = types.StringValue(json.Name)
-	model.Password = types.StringValue(json.XPassword)
-	model.TunnelType = types.Int64Value(int64(json.TunnelType))
-	model.TunnelMediumType = types.Int64Value(int64(json.TunnelMediumType))
-	model.NetworkId = types.StringValue(json.NetworkID)
-}
-
-func parseAccountResourceModel(model resource_account.AccountModel, json *unifi.Account) {
-	json.ID = model.Id.ValueString()
-	json.Name = model.Name.ValueString()
-	json.XPassword = model.Password.ValueString()
-	json.TunnelType = int(model.TunnelType.ValueInt64())
-	json.TunnelMediumType = int(model.TunnelMediumType.ValueInt64())
-	json.NetworkID = model.NetworkId.ValueString()
-}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,19 +83,7 @@ func (d *accountsDataSource) Read(ctx context.Context, req datasource.ReadReques
 }
 
 func parseAccountsDataSourceJson(ctx context.Context, json []unifi.Account, model *datasource_accounts.AccountsModel) diag.Diagnostics {
-	// var accounts []datasource_accounts.AccountsValue
-	// for _, account := range json {
-	// 	accounts = append(accounts, datasource_accounts.AccountsValue{
-	// 		Id:               types.StringValue(account.ID),
-	// 		Name:             types.StringValue(account.Name),
-	// 		Password:         types.StringValue(account.XPassword),
-	// 		TunnelType:       types.Int64Value(int64(account.TunnelType)),
-	// 		TunnelMediumType: types.Int64Value(int64(account.TunnelMediumType)),
-	// 		NetworkId:        types.StringValue(account.NetworkID),
-	// 	})
-	// }
-
-	accountsList, diags := types.ListValueFrom(ctx, datasource_accounts.AccountsValue{}.Type(ctx), json)
+	accountsList, diags := objectListValue(ctx, datasource_accounts.AccountsValue{}.Type(ctx), json, mapAccountsDataSourceJson)
 	if diags.HasError() {
 		return diags
 	}
@@ -10,8 +10,6 @@ import (
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_ap_group"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -88,7 +86,7 @@ func (d *apGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	resp.Diagnostics.Append(parseApGroupDataSourceJson(ctx, *apGroup, &data)...)
+	resp.Diagnostics.Append(mapApGroupDataSourceJson(ctx, *apGroup, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -96,16 +94,3 @@ func (d *apGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-func parseApGroupDataSourceJson(ctx context.Context, json unifi.APGroup, model *datasource_ap_group.ApGroupModel) diag.Diagnostics {
-	model.Id = types.StringValue(json.ID)
-	model.Name = types.StringValue(json.Name)
-
-	deviceMacList, diags := types.ListValueFrom(ctx, types.StringType, json.DeviceMACs)
-	if diags.HasError() {
-		return diags
-	}
-	model.DeviceMacs = deviceMacList
-
-	return nil
-}
@@ -6,12 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/zoullx/terraform-provider-unifi/internal/resource_ap_group"
 
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,9 +17,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &apGroupResource{}
-	_ resource.ResourceWithConfigure   = &apGroupResource{}
-	_ resource.ResourceWithImportState = &apGroupResource{}
+	_ resource.Resource                 = &apGroupResource{}
+	_ resource.ResourceWithConfigure    = &apGroupResource{}
+	_ resource.ResourceWithImportState  = &apGroupResource{}
+	_ resource.ResourceWithUpgradeState = &apGroupResource{}
 )
 
 func NewApGroupResource() resource.Resource {
@@ -38,6 +37,11 @@ func (r *apGroupResource) Metadata(ctx context.Context, req resource.MetadataReq
 
 func (r *apGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_ap_group.ApGroupResourceSchema(ctx)
+	resp.Schema.Version = setSchemaVersion
+}
+
+func (r *apGroupResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return listToSetStateUpgraders(ctx, apGroupSchemaV0(), resource_ap_group.ApGroupResourceSchema(ctx))
 }
 
 func (r *apGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -62,9 +66,8 @@ func (r *apGroupResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 func (r *apGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
 			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
@@ -72,11 +75,16 @@ func (r *apGroupResource) ImportState(ctx context.Context, req resource.ImportSt
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (r *apGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create AP Group")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_ap_group.ApGroupModel
 
 	// Read Terraform plan data into the model
@@ -86,7 +94,7 @@ func (r *apGroupResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	var body unifi.APGroup
-	resp.Diagnostics.Append(parseApGroupResourceModel(ctx, data, &body)...)
+	resp.Diagnostics.Append(mapApGroupResourceModel(ctx, data, &body)...)
 	apGroup, err := r.client.CreateAPGroup(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -96,7 +104,7 @@ func (r *apGroupResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	resp.Diagnostics.Append(parseApGroupResourceJson(ctx, *apGroup, &data)...)
+	resp.Diagnostics.Append(mapApGroupResourceJson(ctx, *apGroup, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -126,7 +134,7 @@ func (r *apGroupResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	resp.Diagnostics.Append(parseApGroupResourceJson(ctx, *apGroup, &data)...)
+	resp.Diagnostics.Append(mapApGroupResourceJson(ctx, *apGroup, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -136,6 +144,11 @@ func (r *apGroupResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *apGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update AP Group")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_ap_group.ApGroupModel
 
 	// Read Terraform plan data into the model
@@ -144,8 +157,16 @@ func (r *apGroupResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	var body unifi.APGroup
-	resp.Diagnostics.Append(parseApGroupResourceModel(ctx, data, &body)...)
+	current, diags := readForUpdate(ctx, r.client, nil, "AP Group", func() (*unifi.APGroup, error) {
+		return r.client.GetAPGroup(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	resp.Diagnostics.Append(mapApGroupResourceModel(ctx, data, &body)...)
 	apGroup, err := r.client.UpdateAPGroup(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -155,7 +176,7 @@ func (r *apGroupResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	resp.Diagnostics.Append(parseApGroupResourceJson(ctx, *apGroup, &data)...)
+	resp.Diagnostics.Append(mapApGroupResourceJson(ctx, *apGroup, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -167,6 +188,11 @@ func (r *apGroupResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *apGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete AP Group")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_ap_group.ApGroupModel
 
 	// Read Terraform prior state data into the model
@@ -185,32 +211,3 @@ func (r *apGroupResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 }
-
-func parseApGroupResourceJson(ctx context.Context, json unifi.APGroup, model *resource_ap_group.ApGroupModel) diag.Diagnostics {
-	model.Id = types.StringValue(json.ID)
-	model.Name = types.StringValue(json.Name)
-
-	deviceMacList, diags := types.ListValueFrom(ctx, types.StringType, json.DeviceMACs)
-	if diags.HasError() {
-		return diags
-	}
-	model.DeviceMacs = deviceMacList
-
-	return nil
-}
-
-func parseApGroupResourceModel(ctx context.Context, model resource_ap_group.ApGroupModel, json *unifi.APGroup) diag.Diagnostics {
-	json.ID = model.Id.ValueString()
-	json.Name = model.Name.ValueString()
-
-	// var deviceMacSlice []string
-	if !model.DeviceMacs.IsUnknown() && !model.DeviceMacs.IsNull() {
-		diags := model.DeviceMacs.ElementsAs(ctx, &json.DeviceMACs, false)
-		if diags.HasError() {
-			return diags
-		}
-	}
-	// json.DeviceMACs = deviceMacSlice
-
-	return nil
-}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,7 +83,7 @@ func (d *apGroupsDataSource) Read(ctx context.Context, req datasource.ReadReques
 }
 
 func parseApGroupsDataSourceJson(ctx context.Context, json []unifi.APGroup, model *datasource_ap_groups.ApGroupsModel) diag.Diagnostics {
-	apGroupsList, diags := types.ListValueFrom(ctx, datasource_ap_groups.ApGroupsValue{}.Type(ctx), json)
+	apGroupsList, diags := objectListValue(ctx, datasource_ap_groups.ApGroupsValue{}.Type(ctx), json, mapApGroupsDataSourceJson)
 	if diags.HasError() {
 		return diags
 	}
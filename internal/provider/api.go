@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// unifiOSPrefix is the path the Network application is served under on
+// UniFi OS consoles.
+const unifiOSPrefix = "/proxy/network"
+
+// apiClient sends requests to the Unifi Network API for the endpoints the
+// unifi.Client doesn't cover, e.g. the v2 API. It authenticates with the API
+// key of the provider and sends requests through the same transport as the
+// unifi.Client.
+type apiClient struct {
+	httpClient *http.Client
+	host       string
+	apiKey     string
+
+	// prefix is the path the Network application is served under, cached
+	// once it has been detected, see apiPrefix.
+	prefixMu sync.Mutex
+	prefix   *string
+}
+
+func newAPIClient(host, apiKey string, transport http.RoundTripper) *apiClient {
+	return &apiClient{
+		httpClient: &http.Client{
+			Transport: transport,
+			// The redirects of standalone controllers are used to tell them
+			// apart from UniFi OS consoles, and are never followed.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		host:   strings.TrimSuffix(host, "/"),
+		apiKey: apiKey,
+	}
+}
+
+// apiError is returned for requests rejected by the controller.
+type apiError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *apiError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("unexpected status %d from the Unifi Controller", e.StatusCode)
+	}
+	return fmt.Sprintf("unexpected status %d from the Unifi Controller: %s", e.StatusCode, e.Message)
+}
+
+// apiMeta is the status of a response of the v1 API.
+type apiMeta struct {
+	RC  string `json:"rc"`
+	Msg string `json:"msg"`
+}
+
+// apiResponse is the envelope of the responses of the v1 API.
+type apiResponse[T any] struct {
+	Meta apiMeta `json:"meta"`
+	Data []T     `json:"data"`
+}
+
+// apiData sends a request to the v1 API endpoint apiPath and returns the
+// objects of the response.
+func apiData[T any](ctx context.Context, c *apiClient, method, apiPath string, reqBody any) ([]T, error) {
+	var resp apiResponse[T]
+	if err := c.do(ctx, method, apiPath, reqBody, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.Meta.RC != "ok" {
+		return nil, &apiError{StatusCode: http.StatusOK, Message: resp.Meta.Msg}
+	}
+
+	return resp.Data, nil
+}
+
+// apiObject sends a request to the v1 API endpoint apiPath and returns the
+// single object of the response, e.g. the object created or updated.
+func apiObject[T any](ctx context.Context, c *apiClient, method, apiPath string, reqBody any) (*T, error) {
+	data, err := apiData[T](ctx, c, method, apiPath, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) != 1 {
+		return nil, fmt.Errorf("unexpected number of results from the Unifi Controller: %d", len(data))
+	}
+
+	return &data[0], nil
+}
+
+// do sends a request to apiPath, relative to the Network application, e.g.
+// "api/s/default/stat/sysinfo" or "v2/api/site/default/trafficrules". reqBody
+// is sent as JSON when not nil, and the response is decoded into respBody
+// when not nil.
+func (c *apiClient) do(ctx context.Context, method, apiPath string, reqBody, respBody any) error {
+	b, err := c.send(ctx, method, apiPath, "application/json", reqBody)
+	if err != nil {
+		return err
+	}
+
+	if respBody == nil || len(bytes.TrimSpace(b)) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(b, respBody); err != nil {
+		return fmt.Errorf("unable to decode the response of the Unifi Controller: %w", err)
+	}
+
+	return nil
+}
+
+// send sends a request to apiPath accepting a response of the media type
+// accept, and returns the body of the response.
+func (c *apiClient) send(ctx context.Context, method, apiPath, accept string, reqBody any) ([]byte, error) {
+	prefix, err := c.apiPrefix(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var body io.Reader
+	if reqBody != nil {
+		b, err := json.Marshal(reqBody)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.host+prefix+"/"+strings.TrimPrefix(apiPath, "/"), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("X-API-KEY", c.apiKey)
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, &apiError{StatusCode: resp.StatusCode, Message: apiErrorMessage(b)}
+	}
+
+	return b, nil
+}
+
+// marshalWithOther returns the JSON document of fields, the value of an API
+// type, together with the settings in other the type doesn't model.
+func marshalWithOther(fields any, other map[string]json.RawMessage) ([]byte, error) {
+	b, err := json.Marshal(fields)
+	if err != nil || len(other) == 0 {
+		return b, err
+	}
+
+	settings := make(map[string]json.RawMessage, len(other))
+	for key, value := range other {
+		settings[key] = value
+	}
+	if err := json.Unmarshal(b, &settings); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(settings)
+}
+
+// unmarshalWithOther decodes the JSON document b into fields, and returns the
+// settings of b which aren't one of keys, the JSON keys of the type of fields.
+func unmarshalWithOther(b []byte, fields any, keys []string) (map[string]json.RawMessage, error) {
+	if err := json.Unmarshal(b, fields); err != nil {
+		return nil, err
+	}
+
+	var settings map[string]json.RawMessage
+	if err := json.Unmarshal(b, &settings); err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		delete(settings, key)
+	}
+
+	return settings, nil
+}
+
+// jsonKeys returns the JSON keys of the exported fields of the struct type t.
+func jsonKeys(t reflect.Type) []string {
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		keys = append(keys, name)
+	}
+
+	return keys
+}
+
+// apiErrorMessage returns the message of an error response of the v1 or the
+// v2 API.
+func apiErrorMessage(body []byte) string {
+	var resp struct {
+		Meta    apiMeta `json:"meta"`
+		Message string  `json:"message"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return strings.TrimSpace(string(body))
+	}
+
+	if resp.Meta.Msg != "" {
+		return resp.Meta.Msg
+	}
+	return resp.Message
+}
+
+// apiPrefix returns the path the Network application is served under. UniFi
+// OS consoles answer a request for the root of the host, while standalone
+// controllers redirect it to their own login page. Errors aren't cached, the
+// next call detects the prefix again.
+func (c *apiClient) apiPrefix(ctx context.Context) (string, error) {
+	c.prefixMu.Lock()
+	defer c.prefixMu.Unlock()
+
+	if c.prefix != nil {
+		return *c.prefix, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.host+"/", nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	prefix := ""
+	if resp.StatusCode == http.StatusOK {
+		prefix = unifiOSPrefix
+	}
+	c.prefix = &prefix
+
+	return prefix, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newAPITestClient returns an apiClient for a standalone controller serving
+// the Network API with handler.
+func newAPITestClient(t *testing.T, handler http.HandlerFunc) *apiClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			http.Redirect(w, r, "/manage", http.StatusFound)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return newAPIClient(server.URL, "api-key", http.DefaultTransport)
+}
+
+func TestAPIClient_Do(t *testing.T) {
+	ctx := context.Background()
+	client := newAPITestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "api-key", r.Header.Get("X-API-KEY"))
+
+		switch r.URL.Path {
+		case "/v2/api/site/default/trafficrules":
+			var body map[string]string
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			_ = json.NewEncoder(w).Encode(map[string]string{"_id": "1", "description": body["description"]})
+		case "/v2/api/site/default/trafficrules/2":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":"api.err.Invalid","message":"Invalid rule"}`))
+		case "/api/s/default/rest/networkconf":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"meta":{"rc":"error","msg":"api.err.VlanUsed"},"data":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	var rule map[string]string
+	err := client.do(ctx, http.MethodPost, "v2/api/site/default/trafficrules", map[string]string{"description": "Block"}, &rule)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"_id": "1", "description": "Block"}, rule)
+
+	err = client.do(ctx, http.MethodPut, "v2/api/site/default/trafficrules/2", map[string]string{}, nil)
+	assert.ErrorContains(t, err, "unexpected status 400 from the Unifi Controller: Invalid rule")
+
+	_, err = apiData[map[string]any](ctx, client, http.MethodPost, "api/s/default/rest/networkconf", map[string]string{})
+	assert.ErrorContains(t, err, "api.err.VlanUsed")
+}
+
+func TestAPIClient_Prefix(t *testing.T) {
+	ctx := context.Background()
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path == "/" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"version":"9.0.108"}]}`))
+	}))
+	defer server.Close()
+
+	// UniFi OS consoles serve the Network application under a prefix, which
+	// is only detected once.
+	client := newAPIClient(server.URL+"/", "api-key", http.DefaultTransport)
+	for range 2 {
+		data, err := apiData[sysInfo](ctx, client, http.MethodGet, "api/s/default/stat/sysinfo", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []sysInfo{{Version: "9.0.108"}}, data)
+	}
+
+	assert.Equal(t, []string{"/", "/proxy/network/api/s/default/stat/sysinfo", "/proxy/network/api/s/default/stat/sysinfo"}, paths)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/netip"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+var _ unifi.Client = &unifiClient{}
+
+// unifiClient wraps the unifi.Client created by the provider with the
+// provider level settings resources need, and details about the controller
+// they are talking to. The controller details are cached once they have been
+// fetched successfully.
+type unifiClient struct {
+	unifi.Client
+
+	// api sends the requests the unifi.Client doesn't cover.
+	api *apiClient
+
+	// readOnly rejects every Create, Update and Delete before the client is
+	// called.
+	readOnly bool
+
+	// forceOverwrite skips the check for objects modified outside Terraform
+	// since the plan was created, see checkFingerprint.
+	forceOverwrite bool
+
+	// lockoutAddresses are the management addresses protected by the lockout
+	// guard, nil when the guard is disabled.
+	lockoutAddresses []netip.Addr
+
+	controllerMu sync.Mutex
+	controller   *controllerInfo
+}
+
+// ControllerInfo returns the cached details of the controller. They are the
+// same for every site, so they are fetched through the first site asked for.
+// Errors aren't cached, the next call fetches the details again.
+func (c *unifiClient) ControllerInfo(ctx context.Context, site string) (*controllerInfo, error) {
+	c.controllerMu.Lock()
+	defer c.controllerMu.Unlock()
+
+	if c.controller == nil {
+		controller, err := getControllerInfo(ctx, c, site)
+		if err != nil {
+			return nil, err
+		}
+		c.controller = controller
+	}
+
+	return c.controller, nil
+}
+
+// checkReadOnly returns an error diagnostic when the provider is in read-only
+// mode. It must be called by every Create, Update and Delete before making
+// any change on the controller. operation describes the change, e.g.
+// "create Network".
+func checkReadOnly(client unifi.Client, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if c, ok := client.(*unifiClient); ok && c.readOnly {
+		diags.AddError(
+			"Provider is Read-Only",
+			"Unable to "+operation+" as the provider is configured in read-only mode. "+
+				"Unset read_only in the provider configuration or the UNIFI_READ_ONLY environment variable to make changes to the Unifi Controller.",
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckReadOnly(t *testing.T) {
+	assert.False(t, checkReadOnly(&unifiClient{}, "create Network").HasError())
+	assert.False(t, checkReadOnly(nil, "create Network").HasError())
+
+	diags := checkReadOnly(&unifiClient{readOnly: true}, "create Network")
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "create Network")
+}
+
+func TestReadOnly_RejectsChangesBeforeCallingClient(t *testing.T) {
+	// The wrapped client is nil, so a resource calling it before checking
+	// for read-only mode panics.
+	client := &unifiClient{readOnly: true}
+	ctx := context.Background()
+
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+		r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+
+		schemaResp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+		// A plan and state with every attribute null can be read into the
+		// model of any resource.
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attributeType := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+		raw := tftypes.NewValue(objectType, attributes)
+
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw}
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: raw}
+
+		createResp := &resource.CreateResponse{State: state}
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+		assertReadOnlyDiagnostics(t, createResp.Diagnostics, "%T Create", r)
+
+		updateResp := &resource.UpdateResponse{State: state}
+		r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, updateResp)
+		assertReadOnlyDiagnostics(t, updateResp.Diagnostics, "%T Update", r)
+
+		deleteResp := &resource.DeleteResponse{State: state}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, deleteResp)
+		assertReadOnlyDiagnostics(t, deleteResp.Diagnostics, "%T Delete", r)
+	}
+}
+
+func assertReadOnlyDiagnostics(t *testing.T, diags diag.Diagnostics, msgAndArgs ...any) {
+	t.Helper()
+
+	if assert.Equal(t, 1, diags.ErrorsCount(), msgAndArgs...) {
+		assert.Equal(t, "Provider is Read-Only", diags.Errors()[0].Summary(), msgAndArgs...)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_network"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_wlan"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+// changed reports whether an attribute is known in the plan and is being
+// created or changed, so unchanged attributes aren't checked again on every
+// plan.
+func changed(planned, prior attr.Value) bool {
+	return isSet(planned) && !planned.Equal(prior)
+}
+
+// networkVlanChanged reports whether the planned network enables a VLAN or
+// changes its VLAN ID.
+func networkVlanChanged(planned, prior resource_network.NetworkModel) bool {
+	return isSet(planned.VlanId) && planned.VlanEnabled.ValueBool() &&
+		(!planned.VlanId.Equal(prior.VlanId) || !planned.VlanEnabled.Equal(prior.VlanEnabled))
+}
+
+// networkConflictsChanged reports whether networkConflicts has anything to
+// check, so the networks of the site are only listed when the VLAN or the
+// subnet changes.
+func networkConflictsChanged(planned, prior resource_network.NetworkModel) bool {
+	return networkVlanChanged(planned, prior) || changed(planned.Subnet, prior.Subnet)
+}
+
+// networkConflicts checks the planned network against the other networks of
+// the site. A VLAN ID used by another network or a subnet overlapping the
+// subnet of another network is an error, as the controller would reject the
+// network halfway through an apply.
+func networkConflicts(planned, prior resource_network.NetworkModel, networks []unifi.Network) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	checkVlan := networkVlanChanged(planned, prior)
+
+	var subnet netip.Prefix
+	if changed(planned.Subnet, prior.Subnet) {
+		subnet, _ = netip.ParsePrefix(planned.Subnet.ValueString())
+	}
+
+	for _, network := range networks {
+		if isSet(planned.Id) && network.ID == planned.Id.ValueString() {
+			continue
+		}
+
+		if checkVlan && network.VLANEnabled && int64(network.VLAN) == planned.VlanId.ValueInt64() {
+			diags.AddAttributeError(
+				path.Root("vlan_id"),
+				"VLAN ID Already in Use",
+				fmt.Sprintf("The VLAN ID %d is already used by the Network %q (ID %s) on the site.", network.VLAN, network.Name, network.ID),
+			)
+		}
+
+		other, err := netip.ParsePrefix(network.IPSubnet)
+		if subnet.IsValid() && err == nil && subnet.Masked().Overlaps(other.Masked()) {
+			diags.AddAttributeError(
+				path.Root("subnet"),
+				"Subnet Overlaps Existing Network",
+				fmt.Sprintf("The subnet %s overlaps the subnet %s of the Network %q (ID %s) on the site.", planned.Subnet.ValueString(), network.IPSubnet, network.Name, network.ID),
+			)
+		}
+	}
+
+	return diags
+}
+
+// wlanConflictsChanged reports whether wlanConflicts has anything to check,
+// so the WLANs of the site are only listed when the SSID changes.
+func wlanConflictsChanged(planned, prior resource_wlan.WlanModel) bool {
+	return changed(planned.Name, prior.Name)
+}
+
+// wlanConflicts checks the planned WLAN against the other WLANs of the site.
+// A duplicate SSID is only a warning, as it can be intended, e.g. when the
+// WLANs are broadcast by different AP groups.
+func wlanConflicts(planned, prior resource_wlan.WlanModel, wlans []unifi.WLAN) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !wlanConflictsChanged(planned, prior) {
+		return diags
+	}
+
+	for _, wlan := range wlans {
+		if isSet(planned.Id) && wlan.ID == planned.Id.ValueString() {
+			continue
+		}
+
+		if wlan.Name == planned.Name.ValueString() {
+			diags.AddAttributeWarning(
+				path.Root("name"),
+				"SSID Already in Use",
+				fmt.Sprintf("The SSID %q is already used by the WLAN with ID %s on the site. "+
+					"Make sure both WLANs aren't broadcast by the same access points.", wlan.Name, wlan.ID),
+			)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_network"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_wlan"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+func TestNetworkConflicts(t *testing.T) {
+	networks := []unifi.Network{
+		{ID: "net-1", Name: "LAN", IPSubnet: "192.168.1.1/24"},
+		{ID: "net-2", Name: "IoT", IPSubnet: "10.0.20.1/24", VLANEnabled: true, VLAN: 20},
+	}
+
+	planned := resource_network.NetworkModel{
+		Id:          types.StringUnknown(),
+		Subnet:      customtypes.NewIPPrefixValue("10.0.30.1/24"),
+		VlanEnabled: types.BoolValue(true),
+		VlanId:      types.Int64Value(30),
+	}
+	assert.False(t, networkConflicts(planned, resource_network.NetworkModel{}, networks).HasError())
+
+	planned.VlanId = types.Int64Value(20)
+	planned.Subnet = customtypes.NewIPPrefixValue("192.168.0.1/16")
+	diags := networkConflicts(planned, resource_network.NetworkModel{}, networks)
+	assert.Equal(t, 2, diags.ErrorsCount())
+
+	// The network itself and unchanged attributes aren't reported.
+	planned.Id = types.StringValue("net-2")
+	assert.Equal(t, 1, networkConflicts(planned, resource_network.NetworkModel{}, networks).ErrorsCount())
+	planned.Id = types.StringValue("net-3")
+	assert.False(t, networkConflicts(planned, planned, networks).HasError())
+}
+
+func TestNetworkConflictsChanged(t *testing.T) {
+	prior := resource_network.NetworkModel{
+		Subnet:      customtypes.NewIPPrefixValue("10.0.30.1/24"),
+		VlanEnabled: types.BoolValue(true),
+		VlanId:      types.Int64Value(30),
+		Name:        types.StringValue("IoT"),
+	}
+
+	planned := prior
+	planned.Name = types.StringValue("Devices")
+	assert.False(t, networkConflictsChanged(planned, prior))
+
+	planned.Subnet = customtypes.NewIPPrefixUnknown()
+	assert.False(t, networkConflictsChanged(planned, prior))
+
+	planned.Subnet = customtypes.NewIPPrefixValue("10.0.40.1/24")
+	assert.True(t, networkConflictsChanged(planned, prior))
+
+	planned = prior
+	planned.VlanId = types.Int64Value(40)
+	assert.True(t, networkConflictsChanged(planned, prior))
+
+	planned.VlanEnabled = types.BoolValue(false)
+	assert.False(t, networkConflictsChanged(planned, prior))
+
+	assert.True(t, networkConflictsChanged(prior, resource_network.NetworkModel{}))
+}
+
+func TestWlanConflicts(t *testing.T) {
+	wlans := []unifi.WLAN{
+		{ID: "wlan-1", Name: "Corp"},
+	}
+
+	planned := resource_wlan.WlanModel{
+		Id:   types.StringUnknown(),
+		Name: types.StringValue("Corp"),
+	}
+	diags := wlanConflicts(planned, resource_wlan.WlanModel{}, wlans)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, diags.WarningsCount())
+
+	planned.Id = types.StringValue("wlan-1")
+	assert.Equal(t, 0, wlanConflicts(planned, resource_wlan.WlanModel{}, wlans).WarningsCount())
+}
+
+func TestWlanConflictsChanged(t *testing.T) {
+	prior := resource_wlan.WlanModel{
+		Name:     types.StringValue("Corp"),
+		HideSsid: types.BoolValue(false),
+	}
+
+	planned := prior
+	planned.HideSsid = types.BoolValue(true)
+	assert.False(t, wlanConflictsChanged(planned, prior))
+
+	planned.Name = types.StringValue("Guest")
+	assert.True(t, wlanConflictsChanged(planned, prior))
+
+	assert.True(t, wlanConflictsChanged(prior, resource_wlan.WlanModel{}))
+}
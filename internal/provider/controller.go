@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+// Capabilities of the Unifi Controller, see controllerCapabilities.
+const (
+	capabilityV2Api    = "v2_api"
+	capabilityWifi7Mlo = "wifi7_mlo"
+)
+
+// controllerCapabilities maps features that depend on the version of the
+// Unifi Network application to the first version supporting them.
+var controllerCapabilities = map[string]string{
+	capabilityV2Api:    "6.1.0",
+	capabilityWifi7Mlo: "8.5.0",
+}
+
+// controllerInfo describes the Unifi Controller the provider is connected to.
+type controllerInfo struct {
+	Version  string
+	UnifiOS  bool
+	Hostname string
+	Uptime   int64
+}
+
+// Supports reports whether the controller version supports capability.
+func (c *controllerInfo) Supports(capability string) bool {
+	minimum, ok := controllerCapabilities[capability]
+	if !ok {
+		return false
+	}
+
+	return versionAtLeast(c.Version, minimum)
+}
+
+// Capabilities returns whether each known capability is supported.
+func (c *controllerInfo) Capabilities() map[string]bool {
+	capabilities := make(map[string]bool, len(controllerCapabilities))
+	for capability := range controllerCapabilities {
+		capabilities[capability] = c.Supports(capability)
+	}
+
+	return capabilities
+}
+
+type sysInfo struct {
+	Version               string `json:"version"`
+	Hostname              string `json:"hostname"`
+	Name                  string `json:"name"`
+	Uptime                int64  `json:"uptime"`
+	UbntDeviceType        string `json:"ubnt_device_type"`
+	UdmVersion            string `json:"udm_version"`
+	ConsoleDisplayVersion string `json:"console_display_version"`
+}
+
+// getControllerInfo fetches the system information of the controller
+// through site. The information is the same for every site, so when site is
+// empty the first site of the controller is used, as there may be no site
+// named "default".
+func getControllerInfo(ctx context.Context, client *unifiClient, site string) (*controllerInfo, error) {
+	if site == "" {
+		sites, err := client.ListSites(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(sites) == 0 {
+			return nil, fmt.Errorf("no sites found on the controller")
+		}
+
+		site = sites[0].Name
+	}
+
+	data, err := apiData[sysInfo](ctx, client.api, http.MethodGet, "api/s/"+url.PathEscape(site)+"/stat/sysinfo", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) != 1 {
+		return nil, fmt.Errorf("unexpected number of system information results: %d", len(data))
+	}
+
+	info := data[0]
+	hostname := info.Hostname
+	if hostname == "" {
+		hostname = info.Name
+	}
+
+	return &controllerInfo{
+		Version:  info.Version,
+		UnifiOS:  info.UbntDeviceType != "" || info.UdmVersion != "" || info.ConsoleDisplayVersion != "",
+		Hostname: hostname,
+		Uptime:   info.Uptime,
+	}, nil
+}
+
+// requireControllerCapability returns an attribute error for attributePath
+// when the controller doesn't support capability, or a resource error when
+// attributePath is empty. site is the site of the resource being checked.
+// When the controller details can't be determined the check is skipped and
+// the controller will validate the request itself.
+func requireControllerCapability(ctx context.Context, client unifi.Client, site, capability string, attributePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c, ok := client.(*unifiClient)
+	if !ok {
+		return diags
+	}
+
+	controller, err := c.ControllerInfo(ctx, site)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to determine Unifi Controller version, skipping capability check", map[string]any{
+			"capability": capability,
+			"error":      err.Error(),
+		})
+		return diags
+	}
+
+	if controller.Supports(capability) {
+		return diags
+	}
+
+	if attributePath.Equal(path.Empty()) {
+		diags.AddError(
+			"Unsupported Controller Feature",
+			fmt.Sprintf("This resource requires Unifi Network %s or later, but the controller is running version %s.",
+				controllerCapabilities[capability], controller.Version),
+		)
+		return diags
+	}
+
+	diags.AddAttributeError(
+		attributePath,
+		"Unsupported Controller Feature",
+		fmt.Sprintf("The %s attribute requires Unifi Network %s or later, but the controller is running version %s.",
+			attributePath, controllerCapabilities[capability], controller.Version),
+	)
+
+	return diags
+}
+
+// versionAtLeast reports whether version is greater than or equal to minimum.
+// Only the leading numeric part of each dot separated component is compared,
+// so build suffixes such as "9.0.108-abc" are ignored.
+func versionAtLeast(version, minimum string) bool {
+	v := parseVersion(version)
+	m := parseVersion(minimum)
+
+	for i := 0; i < max(len(v), len(m)); i++ {
+		var a, b int
+		if i < len(v) {
+			a = v[i]
+		}
+		if i < len(m) {
+			b = m[i]
+		}
+
+		if a != b {
+			return a > b
+		}
+	}
+
+	return true
+}
+
+func parseVersion(version string) []int {
+	var parts []int
+	for _, part := range strings.Split(version, ".") {
+		end := strings.IndexFunc(part, func(r rune) bool { return r < '0' || r > '9' })
+		if end >= 0 {
+			part = part[:end]
+		}
+
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			break
+		}
+		parts = append(parts, n)
+
+		if end >= 0 {
+			break
+		}
+	}
+
+	return parts
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/datasource_controller"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &controllerDataSource{}
+)
+
+func NewControllerDataSource() datasource.DataSource {
+	return &controllerDataSource{}
+}
+
+type controllerDataSource struct {
+	client *unifiClient
+}
+
+func (d *controllerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_controller"
+}
+
+func (d *controllerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_controller.ControllerDataSourceSchema(ctx)
+}
+
+func (d *controllerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *controllerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_controller.ControllerModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get Controller
+	controller, err := getControllerInfo(ctx, d.client, data.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read Controller",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseControllerDataSourceJson(ctx, *controller, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func parseControllerDataSourceJson(ctx context.Context, json controllerInfo, model *datasource_controller.ControllerModel) diag.Diagnostics {
+	model.Version = types.StringValue(json.Version)
+	model.UnifiOs = types.BoolValue(json.UnifiOS)
+	model.Hostname = types.StringValue(json.Hostname)
+	model.Uptime = types.Int64Value(json.Uptime)
+
+	capabilityMap, diags := types.MapValueFrom(ctx, types.BoolType, json.Capabilities())
+	if diags.HasError() {
+		return diags
+	}
+	model.Capabilities = capabilityMap
+
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/datasource_controller"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+type controllerTestClient struct {
+	unifi.Client
+
+	sites []unifi.Site
+}
+
+func (c *controllerTestClient) ListSites(_ context.Context) ([]unifi.Site, error) {
+	return c.sites, nil
+}
+
+// newControllerTestClient returns a client for a controller running version
+// with sites. The paths of the system information requests are recorded in
+// the returned slice.
+func newControllerTestClient(t *testing.T, version string, sites ...unifi.Site) (*unifiClient, *[]string) {
+	var paths []string
+	api := newAPITestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_ = json.NewEncoder(w).Encode(apiResponse[sysInfo]{
+			Meta: apiMeta{RC: "ok"},
+			Data: []sysInfo{{Version: version, Hostname: "udm-pro"}},
+		})
+	})
+
+	return &unifiClient{Client: &controllerTestClient{sites: sites}, api: api}, &paths
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	cases := []struct {
+		version string
+		minimum string
+		want    bool
+	}{
+		{"9.0.108", "9.0.0", true},
+		{"8.6.9", "9.0.0", false},
+		{"8.5.0", "8.5.0", true},
+		{"8.4.59", "8.5.0", false},
+		{"10.0.1", "9.0.0", true},
+		{"9.1", "9.0.5", true},
+		{"9.0.108-beta.1", "9.0.108", true},
+		{"", "6.1.0", false},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.want, versionAtLeast(c.version, c.minimum), "%s >= %s", c.version, c.minimum)
+	}
+}
+
+func TestControllerInfo_Capabilities(t *testing.T) {
+	info := controllerInfo{Version: "8.4.59"}
+
+	assert.Equal(t, map[string]bool{
+		capabilityV2Api:    true,
+		capabilityWifi7Mlo: false,
+	}, info.Capabilities())
+	assert.False(t, info.Supports("unknown"))
+}
+
+func TestControllerDataSource_Parse(t *testing.T) {
+	info := controllerInfo{
+		Version:  "9.0.108",
+		UnifiOS:  true,
+		Hostname: "udm-pro",
+		Uptime:   3600,
+	}
+	model := &datasource_controller.ControllerModel{}
+
+	diags := parseControllerDataSourceJson(context.Background(), info, model)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "9.0.108", model.Version.ValueString())
+	assert.True(t, model.UnifiOs.ValueBool())
+	assert.Equal(t, "udm-pro", model.Hostname.ValueString())
+	assert.Equal(t, int64(3600), model.Uptime.ValueInt64())
+	assert.Len(t, model.Capabilities.Elements(), 2)
+}
+
+func TestGetControllerInfo_Site(t *testing.T) {
+	ctx := context.Background()
+	client, paths := newControllerTestClient(t, "9.0.108", unifi.Site{Name: "a1b2c3d4"}, unifi.Site{Name: "branch"})
+
+	info, err := getControllerInfo(ctx, client, "branch")
+	assert.NoError(t, err)
+	assert.Equal(t, "9.0.108", info.Version)
+
+	// Without a site, the first site of the controller is used.
+	_, err = getControllerInfo(ctx, client, "")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"/api/s/branch/stat/sysinfo", "/api/s/a1b2c3d4/stat/sysinfo"}, *paths)
+
+	client, _ = newControllerTestClient(t, "9.0.108")
+	_, err = getControllerInfo(ctx, client, "")
+	assert.Error(t, err)
+}
+
+func TestUnifiClient_ControllerInfoRetriesErrors(t *testing.T) {
+	ctx := context.Background()
+	client, paths := newControllerTestClient(t, "9.0.108")
+
+	// The controller has no sites yet, so the details can't be fetched.
+	_, err := client.ControllerInfo(ctx, "")
+	assert.Error(t, err)
+
+	client.Client = &controllerTestClient{sites: []unifi.Site{{Name: "default"}}}
+	info, err := client.ControllerInfo(ctx, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "9.0.108", info.Version)
+
+	// Once fetched, the details are cached.
+	_, err = client.ControllerInfo(ctx, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/api/s/default/stat/sysinfo"}, *paths)
+}
+
+func TestRequireControllerCapability(t *testing.T) {
+	ctx := context.Background()
+	client, _ := newControllerTestClient(t, "6.0.45")
+
+	diags := requireControllerCapability(ctx, client, "default", capabilityV2Api, path.Empty())
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "This resource requires Unifi Network 6.1.0 or later")
+
+	diags = requireControllerCapability(ctx, client, "default", capabilityWifi7Mlo, path.Root("mlo_enabled"))
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "The mlo_enabled attribute requires Unifi Network 8.5.0 or later")
+
+	client, _ = newControllerTestClient(t, "9.0.108")
+	assert.False(t, requireControllerCapability(ctx, client, "default", capabilityV2Api, path.Empty()).HasError())
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// checkDeletionProtection returns an error diagnostic when deletion
+// protection is enabled for a resource. It is called by ModifyPlan when a
+// destroy is planned and again by Delete. name describes the resource, e.g.
+// "Network".
+func checkDeletionProtection(deletionProtection types.Bool, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if deletionProtection.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("deletion_protection"),
+			"Deletion Protection Enabled",
+			"The "+name+" can't be destroyed while deletion_protection is true. "+
+				"Set deletion_protection to false and apply the change before destroying it.",
+		)
+	}
+
+	return diags
+}
+
+// deletionProtectionValue returns the deletion_protection value to save in
+// the state read from the controller. Imported resources have no value yet
+// and aren't protected.
+func deletionProtectionValue(deletionProtection types.Bool) types.Bool {
+	if deletionProtection.IsNull() {
+		return types.BoolValue(false)
+	}
+
+	return deletionProtection
+}
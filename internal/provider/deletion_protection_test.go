@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckDeletionProtection(t *testing.T) {
+	assert.False(t, checkDeletionProtection(types.BoolValue(false), "Network").HasError())
+	assert.False(t, checkDeletionProtection(types.BoolNull(), "Network").HasError())
+	assert.True(t, checkDeletionProtection(types.BoolValue(true), "Network").HasError())
+}
+
+func TestDeletionProtectionValue(t *testing.T) {
+	assert.Equal(t, types.BoolValue(false), deletionProtectionValue(types.BoolNull()))
+	assert.Equal(t, types.BoolValue(true), deletionProtectionValue(types.BoolValue(true)))
+}
+
+func TestDeletionProtection_RejectsDeleteBeforeCallingClient(t *testing.T) {
+	ctx := context.Background()
+
+	for _, r := range []resource.Resource{NewSiteResource(), NewNetworkResource(), NewWlanResource(), NewDeviceResource()} {
+		// The wrapped client is nil, so any call to it would panic.
+		r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: &unifiClient{}}, &resource.ConfigureResponse{})
+
+		schemaResp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+		state := tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+		diags := state.SetAttribute(ctx, path.Root("deletion_protection"), true)
+		assert.False(t, diags.HasError(), "%T", r)
+
+		planResp := &resource.ModifyPlanResponse{}
+		r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{
+			State: state,
+			Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+		}, planResp)
+		assert.True(t, planResp.Diagnostics.HasError(), "%T ModifyPlan", r)
+
+		deleteResp := &resource.DeleteResponse{}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, deleteResp)
+		assert.True(t, deleteResp.Diagnostics.HasError(), "%T Delete", r)
+	}
+}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,12 +83,9 @@ func (d *deviceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 }
 
 func parseDeviceDataSourceJson(ctx context.Context, json unifi.Device, model *datasource_device.DeviceModel) diag.Diagnostics {
-	model.Id = types.StringValue(json.ID)
-	model.Disabled = types.BoolValue(json.Disabled)
-	model.Mac = types.StringValue(json.MAC)
-	model.Name = types.StringValue(json.Name)
+	mapDeviceDataSourceJson(json, model)
 
-	portOverrideList, diags := types.ListValueFrom(ctx, datasource_device.PortOverridesValue{}.Type(ctx), json.PortOverrides)
+	portOverrideList, diags := portOverridesValue(ctx, datasource_device.PortOverridesValue{}.Type(ctx), json.PortOverrides)
 	if diags.HasError() {
 		return diags
 	}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_device"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+// portOverridesValue converts the port overrides of a device to a list of
+// elemType, the PortOverridesValue type of the resource or a data source.
+func portOverridesValue(ctx context.Context, elemType attr.Type, overrides []unifi.DevicePortOverrides) (types.List, diag.Diagnostics) {
+	return objectListValue(ctx, elemType, overrides, portOverrideAttributes)
+}
+
+func portOverrideAttributes(_ context.Context, json unifi.DevicePortOverrides, attributes map[string]attr.Value) diag.Diagnostics {
+	attributes["aggregate_num_ports"] = types.Int64Value(int64(json.AggregateNumPorts))
+	attributes["name"] = types.StringValue(json.Name)
+	attributes["number"] = types.Int64Value(int64(json.PortIDX))
+	attributes["op_mode"] = types.StringValue(json.OpMode)
+	attributes["poe_mode"] = types.StringValue(json.PoeMode)
+	attributes["port_profile_id"] = types.StringValue(json.PortProfileID)
+
+	return nil
+}
+
+// portOverridesJson converts the configured port overrides of a device
+// resource to the API type. Each override is matched with the current
+// override of the same port by number, so the port settings Terraform
+// doesn't manage are kept. Ports left out of the list lose their override.
+func portOverridesJson(ctx context.Context, list types.List, current []unifi.DevicePortOverrides) ([]unifi.DevicePortOverrides, diag.Diagnostics) {
+	var values []resource_device.PortOverridesValue
+	diags := list.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	overrides := make([]unifi.DevicePortOverrides, 0, len(values))
+	for _, value := range values {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		override := unifi.DevicePortOverrides{PortIDX: int(value.Number.ValueInt64())}
+		for _, c := range current {
+			if c.PortIDX == override.PortIDX {
+				override = c
+				break
+			}
+		}
+
+		if isSet(value.AggregateNumPorts) {
+			override.AggregateNumPorts = int(value.AggregateNumPorts.ValueInt64())
+		}
+		if isSet(value.Name) {
+			override.Name = value.Name.ValueString()
+		}
+		if isSet(value.OpMode) {
+			override.OpMode = value.OpMode.ValueString()
+		}
+		if isSet(value.PoeMode) {
+			override.PoeMode = value.PoeMode.ValueString()
+		}
+		if isSet(value.PortProfileId) {
+			override.PortProfileID = value.PortProfileId.ValueString()
+		}
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides, diags
+}
@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/zoullx/terraform-provider-unifi/internal/resource_device"
@@ -22,6 +21,7 @@ var (
 	_ resource.Resource                = &deviceResource{}
 	_ resource.ResourceWithConfigure   = &deviceResource{}
 	_ resource.ResourceWithImportState = &deviceResource{}
+	_ resource.ResourceWithModifyPlan  = &deviceResource{}
 )
 
 func NewDeviceResource() resource.Resource {
@@ -62,9 +62,8 @@ func (r *deviceResource) Configure(ctx context.Context, req resource.ConfigureRe
 }
 
 func (r *deviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
 			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
@@ -72,11 +71,25 @@ func (r *deviceResource) ImportState(ctx context.Context, req resource.ImportSta
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *deviceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Refuse to plan the destroy of a protected Device.
+	if req.Plan.Raw.IsNull() {
+		var prior resource_device.DeviceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+		resp.Diagnostics.Append(checkDeletionProtection(prior.DeletionProtection, "Device")...)
+	}
 }
 
 func (r *deviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create Device")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_device.DeviceModel
 
 	// Read Terraform plan data into the model
@@ -131,11 +144,18 @@ func (r *deviceResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	data.DeletionProtection = deletionProtectionValue(data.DeletionProtection)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update Device")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_device.DeviceModel
 
 	// Read Terraform plan data into the model
@@ -144,7 +164,15 @@ func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	var body unifi.Device
+	current, diags := readForUpdate(ctx, r.client, nil, "Device", func() (*unifi.Device, error) {
+		return r.client.GetDevice(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
 	resp.Diagnostics.Append(parseDeviceResourceModel(ctx, data, &body)...)
 	device, err := r.client.UpdateDevice(ctx, data.Site.ValueString(), &body)
 	if err != nil {
@@ -167,6 +195,11 @@ func (r *deviceResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *deviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete Device")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_device.DeviceModel
 
 	// Read Terraform prior state data into the model
@@ -175,6 +208,11 @@ func (r *deviceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(checkDeletionProtection(data.DeletionProtection, "Device")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete existing Device
 	err := r.client.DeleteDevice(ctx, data.Site.ValueString(), data.Id.ValueString())
 	if err != nil {
@@ -187,12 +225,9 @@ func (r *deviceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func parseDeviceResourceJson(ctx context.Context, json unifi.Device, model *resource_device.DeviceModel) diag.Diagnostics {
-	model.Id = types.StringValue(json.ID)
-	model.Disabled = types.BoolValue(json.Disabled)
-	model.Mac = types.StringValue(json.MAC)
-	model.Name = types.StringValue(json.Name)
+	mapDeviceResourceJson(json, model)
 
-	portOverrideList, diags := types.ListValueFrom(ctx, resource_device.PortOverridesValue{}.Type(ctx), json.PortOverrides)
+	portOverrideList, diags := portOverridesValue(ctx, resource_device.PortOverridesValue{}.Type(ctx), json.PortOverrides)
 	if diags.HasError() {
 		return diags
 	}
@@ -202,16 +237,15 @@ func parseDeviceResourceJson(ctx context.Context, json unifi.Device, model *reso
 }
 
 func parseDeviceResourceModel(ctx context.Context, model resource_device.DeviceModel, json *unifi.Device) diag.Diagnostics {
-	json.ID = model.Id.ValueString()
-	json.Disabled = model.Disabled.ValueBool()
-	json.MAC = model.Mac.ValueString()
-	json.Name = model.Name.ValueString()
+	mapDeviceResourceModel(model, json)
 
-	if !model.PortOverrides.IsUnknown() && !model.PortOverrides.IsNull() {
-		diags := model.PortOverrides.ElementsAs(ctx, &json.PortOverrides, false)
+	// Without configured port overrides, the current ones are kept.
+	if isSet(model.PortOverrides) {
+		portOverrides, diags := portOverridesJson(ctx, model.PortOverrides, json.PortOverrides)
 		if diags.HasError() {
 			return diags
 		}
+		json.PortOverrides = portOverrides
 	}
 
 	return nil
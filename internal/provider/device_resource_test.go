@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_device"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+func TestDeviceResource_ParseModelPreservesUnmanagedFields(t *testing.T) {
+	current := unifi.Device{
+		ID:            "dev-123",
+		MAC:           "00:11:22:33:44:55",
+		Name:          "old-name",
+		LedOverride:   "off",
+		PortOverrides: []unifi.DevicePortOverrides{{PortIDX: 1, Name: "uplink", Speed: 1000}},
+	}
+	model := resource_device.DeviceModel{
+		Id:            types.StringValue("dev-123"),
+		Mac:           customtypes.NewMacAddressValue("00:11:22:33:44:55"),
+		Name:          types.StringValue("new-name"),
+		Disabled:      types.BoolValue(false),
+		PortOverrides: types.ListNull(resource_device.PortOverridesValue{}.Type(context.Background())),
+	}
+
+	body := current
+	diags := parseDeviceResourceModel(context.Background(), model, &body)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "new-name", body.Name)
+	assert.Equal(t, "off", body.LedOverride)
+	assert.Equal(t, current.PortOverrides, body.PortOverrides)
+}
+
+func TestDeviceResource_ParseModelOverlaysPortOverrides(t *testing.T) {
+	ctx := context.Background()
+	attrTypes := resource_device.PortOverridesValue{}.AttributeTypes(ctx)
+
+	current := unifi.Device{
+		ID: "dev-123",
+		PortOverrides: []unifi.DevicePortOverrides{
+			{PortIDX: 1, Name: "uplink", OpMode: "switch", Autoneg: true, Speed: 1000},
+			{PortIDX: 2, Name: "camera", PoeMode: "auto", Isolation: true},
+		},
+	}
+
+	override := func(number int64, name string, poeMode attr.Value) attr.Value {
+		return resource_device.NewPortOverridesValueMust(attrTypes, map[string]attr.Value{
+			"aggregate_num_ports": types.Int64Unknown(),
+			"name":                types.StringValue(name),
+			"number":              types.Int64Value(number),
+			"op_mode":             types.StringUnknown(),
+			"poe_mode":            poeMode,
+			"port_profile_id":     types.StringUnknown(),
+		})
+	}
+	model := resource_device.DeviceModel{
+		Id: types.StringValue("dev-123"),
+		PortOverrides: types.ListValueMust(resource_device.PortOverridesValue{}.Type(ctx), []attr.Value{
+			override(2, "doorbell", types.StringValue("off")),
+			override(5, "printer", types.StringUnknown()),
+		}),
+	}
+
+	body := current
+	diags := parseDeviceResourceModel(ctx, model, &body)
+	assert.False(t, diags.HasError(), diags)
+
+	// The settings Terraform doesn't manage are kept for port 2, port 5 gets a
+	// new override and port 1 is no longer overridden.
+	assert.Equal(t, []unifi.DevicePortOverrides{
+		{PortIDX: 2, Name: "doorbell", PoeMode: "off", Isolation: true},
+		{PortIDX: 5, Name: "printer"},
+	}, body.PortOverrides)
+
+	var state resource_device.DeviceModel
+	diags = parseDeviceResourceJson(ctx, body, &state)
+	assert.False(t, diags.HasError(), diags)
+
+	var values []resource_device.PortOverridesValue
+	assert.False(t, state.PortOverrides.ElementsAs(ctx, &values, false).HasError())
+	assert.Equal(t, 2, len(values))
+	assert.Equal(t, types.Int64Value(2), values[0].Number)
+	assert.Equal(t, types.StringValue("doorbell"), values[0].Name)
+	assert.Equal(t, types.StringValue("off"), values[0].PoeMode)
+}
@@ -9,9 +9,9 @@ import (
 
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_devices"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,7 +84,7 @@ func (d *devicesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 }
 
 func parseDevicesDataSourceJson(ctx context.Context, json []unifi.Device, model *datasource_devices.DevicesModel) diag.Diagnostics {
-	devicesList, diags := types.ListValueFrom(ctx, datasource_devices.DevicesValue{}.Type(ctx), json)
+	devicesList, diags := objectListValue(ctx, datasource_devices.DevicesValue{}.Type(ctx), json, parseDevicesDataSourceItemJson)
 	if diags.HasError() {
 		return diags
 	}
@@ -92,3 +92,13 @@ func parseDevicesDataSourceJson(ctx context.Context, json []unifi.Device, model
 
 	return nil
 }
+
+func parseDevicesDataSourceItemJson(ctx context.Context, json unifi.Device, attributes map[string]attr.Value) diag.Diagnostics {
+	diags := mapDevicesDataSourceJson(ctx, json, attributes)
+
+	portOverrideList, d := portOverridesValue(ctx, datasource_devices.PortOverridesValue{}.Type(ctx), json.PortOverrides)
+	diags.Append(d...)
+	attributes["port_overrides"] = portOverrideList
+
+	return diags
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &dhcpRangeFunction{}
+
+var dhcpRangeAttributeTypes = map[string]attr.Type{
+	"start": types.StringType,
+	"stop":  types.StringType,
+}
+
+func NewDhcpRangeFunction() function.Function {
+	return &dhcpRangeFunction{}
+}
+
+// dhcpRangeFunction computes the dhcp_start and dhcp_stop addresses of a
+// network from its subnet.
+type dhcpRangeFunction struct{}
+
+func (f *dhcpRangeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dhcp_range"
+}
+
+func (f *dhcpRangeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compute the DHCP range of an IPv4 subnet.",
+		MarkdownDescription: "Computes the DHCP range of an IPv4 subnet in CIDR notation, as used by the `subnet` attribute of `unifi_network`. " +
+			"The range spans the host addresses of the subnet, without the first `reserve_start` and the last `reserve_end` host addresses, " +
+			"e.g. `192.168.1.6` to `192.168.1.254` for `192.168.1.1/24` with 5 and 0 reserved addresses. " +
+			"Returns an object with the `start` and `stop` attributes, for the `dhcp_start` and `dhcp_stop` attributes of `unifi_network`. " +
+			"The gateway address of the subnet must not be inside the range.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "The gateway address and prefix length of the subnet, e.g. `192.168.1.1/24`.",
+			},
+			function.Int64Parameter{
+				Name:                "reserve_start",
+				MarkdownDescription: "The number of host addresses to leave out at the start of the subnet, e.g. for the gateway and static addresses.",
+			},
+			function.Int64Parameter{
+				Name:                "reserve_end",
+				MarkdownDescription: "The number of host addresses to leave out at the end of the subnet.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: dhcpRangeAttributeTypes,
+		},
+	}
+}
+
+func (f *dhcpRangeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var reserveStart, reserveEnd int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &reserveStart, &reserveEnd))
+	if resp.Error != nil {
+		return
+	}
+
+	start, stop, funcErr := dhcpRange(cidr, reserveStart, reserveEnd)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	result, diags := types.ObjectValue(dhcpRangeAttributeTypes, map[string]attr.Value{
+		"start": types.StringValue(start.String()),
+		"stop":  types.StringValue(stop.String()),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// dhcpRange returns the first and last address of the DHCP range of the IPv4
+// subnet cidr, leaving out reserveStart and reserveEnd host addresses.
+func dhcpRange(cidr string, reserveStart, reserveEnd int64) (netip.Addr, netip.Addr, *function.FuncError) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil || !prefix.Addr().Is4() {
+		return netip.Addr{}, netip.Addr{}, function.NewArgumentFuncError(0, fmt.Sprintf("Expected an IPv4 subnet in CIDR notation, e.g. 192.168.1.1/24. Got: %q", cidr))
+	}
+	if reserveStart < 0 {
+		return netip.Addr{}, netip.Addr{}, function.NewArgumentFuncError(1, "The number of reserved addresses must not be negative.")
+	}
+	if reserveEnd < 0 {
+		return netip.Addr{}, netip.Addr{}, function.NewArgumentFuncError(2, "The number of reserved addresses must not be negative.")
+	}
+
+	network := prefix.Masked().Addr().As4()
+	first := int64(binary.BigEndian.Uint32(network[:])) + 1
+	last := first + int64(1)<<(32-prefix.Bits()) - 3
+
+	startValue := first + reserveStart
+	stopValue := last - reserveEnd
+	if startValue > stopValue {
+		return netip.Addr{}, netip.Addr{}, function.NewFuncError(fmt.Sprintf("The subnet %s has no host addresses left for the DHCP range after reserving %d at the start and %d at the end.", cidr, reserveStart, reserveEnd))
+	}
+
+	start, stop := int64ToAddr(startValue), int64ToAddr(stopValue)
+
+	gateway := prefix.Addr()
+	if !gateway.Less(start) && !stop.Less(gateway) {
+		// Point at the reservation that excludes the gateway with the fewest
+		// addresses, depending on the half of the subnet it is in.
+		gatewayBytes := gateway.As4()
+		argument, side := int64(1), "start"
+		if int64(binary.BigEndian.Uint32(gatewayBytes[:])) > (first+last)/2 {
+			argument, side = 2, "end"
+		}
+
+		return netip.Addr{}, netip.Addr{}, function.NewArgumentFuncError(argument, fmt.Sprintf("The gateway address %s of the subnet is inside the DHCP range %s - %s. Reserve more addresses at the %s of the subnet to exclude it.", gateway, start, stop, side))
+	}
+
+	return start, stop, nil
+}
+
+func int64ToAddr(value int64) netip.Addr {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(value))
+	return netip.AddrFrom4(b)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDhcpRange(t *testing.T) {
+	for _, tc := range []struct {
+		cidr                     string
+		reserveStart, reserveEnd int64
+		start, stop              string
+	}{
+		{"192.168.1.1/24", 5, 0, "192.168.1.6", "192.168.1.254"},
+		{"192.168.1.1/24", 9, 54, "192.168.1.10", "192.168.1.200"},
+		{"10.0.1.254/23", 0, 1, "10.0.0.1", "10.0.1.253"},
+		{"172.16.0.1/30", 1, 0, "172.16.0.2", "172.16.0.2"},
+	} {
+		start, stop, err := dhcpRange(tc.cidr, tc.reserveStart, tc.reserveEnd)
+		if assert.Nil(t, err, tc.cidr) {
+			assert.Equal(t, tc.start, start.String(), tc.cidr)
+			assert.Equal(t, tc.stop, stop.String(), tc.cidr)
+		}
+	}
+
+	for _, tc := range []struct {
+		cidr                     string
+		reserveStart, reserveEnd int64
+		message                  string
+	}{
+		{"192.168.1.1", 0, 0, "Expected an IPv4 subnet"},
+		{"fd00::1/64", 0, 0, "Expected an IPv4 subnet"},
+		{"192.168.1.1/24", -1, 0, "must not be negative"},
+		{"192.168.1.1/24", 200, 100, "no host addresses left"},
+		{"192.168.1.1/31", 0, 0, "no host addresses left"},
+		{"192.168.1.1/24", 0, 0, "gateway address 192.168.1.1"},
+	} {
+		_, _, err := dhcpRange(tc.cidr, tc.reserveStart, tc.reserveEnd)
+		if assert.NotNil(t, err, tc.cidr) {
+			assert.Contains(t, err.Error(), tc.message, tc.cidr)
+		}
+	}
+}
+
+func TestDhcpRange_GatewayArgument(t *testing.T) {
+	for _, tc := range []struct {
+		cidr     string
+		argument int64
+	}{
+		{"192.168.1.1/24", 1},
+		{"192.168.1.127/24", 1},
+		{"192.168.1.128/24", 2},
+		{"192.168.1.254/24", 2},
+	} {
+		_, _, err := dhcpRange(tc.cidr, 0, 0)
+		if assert.NotNil(t, err, tc.cidr) && assert.NotNil(t, err.FunctionArgument, tc.cidr) {
+			assert.Equal(t, tc.argument, *err.FunctionArgument, tc.cidr)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// dnsRecord is a static DNS record of the v2 API.
+type dnsRecord struct {
+	ID         string `json:"_id,omitempty"`
+	SiteID     string `json:"site_id,omitempty"`
+	Key        string `json:"key"`
+	RecordType string `json:"record_type"`
+	Value      string `json:"value"`
+	Ttl        int    `json:"ttl"`
+	Priority   int    `json:"priority"`
+	Weight     int    `json:"weight"`
+	Port       int    `json:"port"`
+	Enabled    bool   `json:"enabled"`
+}
+
+func dnsRecordsPath(site, id string) string {
+	apiPath := "v2/api/site/" + url.PathEscape(site) + "/static-dns"
+	if id != "" {
+		apiPath += "/" + url.PathEscape(id)
+	}
+	return apiPath
+}
+
+func (c *apiClient) listDNSRecords(ctx context.Context, site string) ([]dnsRecord, error) {
+	var records []dnsRecord
+	if err := c.do(ctx, http.MethodGet, dnsRecordsPath(site, ""), nil, &records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func (c *apiClient) getDNSRecord(ctx context.Context, site, id string) (*dnsRecord, error) {
+	// The v2 API only lists the DNS records of a site.
+	records, err := c.listDNSRecords(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		if record.ID == id {
+			return &record, nil
+		}
+	}
+
+	return nil, fmt.Errorf("DNS record %s not found", id)
+}
+
+func (c *apiClient) createDNSRecord(ctx context.Context, site string, record *dnsRecord) (*dnsRecord, error) {
+	var created dnsRecord
+	if err := c.do(ctx, http.MethodPost, dnsRecordsPath(site, ""), record, &created); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (c *apiClient) updateDNSRecord(ctx context.Context, site string, record *dnsRecord) (*dnsRecord, error) {
+	var updated dnsRecord
+	if err := c.do(ctx, http.MethodPut, dnsRecordsPath(site, record.ID), record, &updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (c *apiClient) deleteDNSRecord(ctx context.Context, site, id string) error {
+	return c.do(ctx, http.MethodDelete, dnsRecordsPath(site, id), nil, nil)
+}
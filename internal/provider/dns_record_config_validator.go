@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_dns_record"
+)
+
+var _ resource.ConfigValidator = dnsRecordConfigValidator{}
+
+// dnsRecordConfigValidator checks that the value and the SRV and MX
+// attributes of a unifi_dns_record match its type.
+type dnsRecordConfigValidator struct{}
+
+func (v dnsRecordConfigValidator) Description(_ context.Context) string {
+	return "The value must match the record type, priority can only be set for MX and SRV records, and weight and " +
+		"port only for SRV records, which require a port"
+}
+
+func (v dnsRecordConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dnsRecordConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resource_dns_record.DnsRecordModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDnsRecordValue(data)...)
+	resp.Diagnostics.Append(validateDnsRecordService(data)...)
+}
+
+// validateDnsRecordValue checks that the value of A, AAAA and
+// FORWARD_DOMAIN records is an address of the right family.
+func validateDnsRecordValue(data resource_dns_record.DnsRecordModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !isSet(data.Value) {
+		return diags
+	}
+
+	addr, err := netip.ParseAddr(data.Value.ValueString())
+
+	var valid bool
+	var expected string
+	switch data.Type.ValueString() {
+	case "A":
+		valid, expected = err == nil && addr.Is4(), "an IPv4 address"
+	case "AAAA":
+		valid, expected = err == nil && addr.Is6() && !addr.Is4In6(), "an IPv6 address"
+	case "FORWARD_DOMAIN":
+		valid, expected = err == nil, "the IP address of a DNS server"
+	default:
+		return diags
+	}
+
+	if !valid {
+		diags.AddAttributeError(
+			path.Root("value"),
+			"Invalid DNS Record Configuration",
+			fmt.Sprintf("The value of %s records must be %s, got: %q.", data.Type.ValueString(), expected, data.Value.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// validateDnsRecordService checks that priority is only set for MX and SRV
+// records, and weight and port only for SRV records, which require a port.
+func validateDnsRecordService(data resource_dns_record.DnsRecordModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	recordType := data.Type.ValueString()
+
+	if recordType == "SRV" && data.Port.IsNull() {
+		diags.AddAttributeError(
+			path.Root("port"),
+			"Missing DNS Record Configuration",
+			"The port attribute is required for SRV records.",
+		)
+	}
+
+	attributes := map[string]attr.Value{
+		"port":     data.Port,
+		"priority": data.Priority,
+		"weight":   data.Weight,
+	}
+	recordTypes := map[string][]string{
+		"port":     {"SRV"},
+		"priority": {"MX", "SRV"},
+		"weight":   {"SRV"},
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		if isSet(attributes[name]) && !slices.Contains(recordTypes[name], recordType) {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid DNS Record Configuration",
+				fmt.Sprintf("The %s attribute can't be set for %s records.", name, recordType),
+			)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_dns_record"
+)
+
+func TestValidateDnsRecordValue(t *testing.T) {
+	cases := []struct {
+		recordType string
+		value      string
+		valid      bool
+	}{
+		{"A", "10.0.10.5", true},
+		{"A", "fd00::5", false},
+		{"A", "nas.home.arpa", false},
+		{"AAAA", "fd00::5", true},
+		{"AAAA", "10.0.10.5", false},
+		{"FORWARD_DOMAIN", "10.0.0.53", true},
+		{"FORWARD_DOMAIN", "dns.example.com", false},
+		{"CNAME", "nas.home.arpa", true},
+		{"TXT", "v=spf1 -all", true},
+	}
+
+	for _, c := range cases {
+		model := resource_dns_record.DnsRecordModel{
+			Type:  types.StringValue(c.recordType),
+			Value: types.StringValue(c.value),
+		}
+		assert.Equal(t, c.valid, !validateDnsRecordValue(model).HasError(), "%s %s", c.recordType, c.value)
+	}
+}
+
+func TestValidateDnsRecordService(t *testing.T) {
+	model := resource_dns_record.DnsRecordModel{
+		Type:     types.StringValue("SRV"),
+		Priority: types.Int64Value(10),
+		Weight:   types.Int64Value(5),
+	}
+	assert.Equal(t, 1, validateDnsRecordService(model).ErrorsCount())
+
+	model.Port = types.Int64Value(5060)
+	assert.False(t, validateDnsRecordService(model).HasError())
+
+	model.Type = types.StringValue("MX")
+	assert.Equal(t, 2, validateDnsRecordService(model).ErrorsCount())
+
+	model.Type = types.StringValue("A")
+	assert.Equal(t, 3, validateDnsRecordService(model).ErrorsCount())
+}
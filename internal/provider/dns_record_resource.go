@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_dns_record"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &dnsRecordResource{}
+	_ resource.ResourceWithConfigure        = &dnsRecordResource{}
+	_ resource.ResourceWithConfigValidators = &dnsRecordResource{}
+	_ resource.ResourceWithImportState      = &dnsRecordResource{}
+)
+
+func NewDnsRecordResource() resource.Resource {
+	return &dnsRecordResource{}
+}
+
+type dnsRecordResource struct {
+	client *unifiClient
+}
+
+func (r *dnsRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record"
+}
+
+func (r *dnsRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_dns_record.DnsRecordResourceSchema(ctx)
+}
+
+func (r *dnsRecordResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		dnsRecordConfigValidator{},
+	}
+}
+
+func (r *dnsRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nill check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create DNS Record")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_dns_record.DnsRecordModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var body dnsRecord
+	mapDnsRecordResourceModel(data, &body)
+	record, err := r.client.api.createDNSRecord(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating DNS Record",
+			"Could not create DNS Record, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapDnsRecordResourceJson(*record, &data)
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *record)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_dns_record.DnsRecordModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed DNS Record value from Unifi
+	record, err := r.client.api.getDNSRecord(ctx, data.Site.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS Record",
+			"Could not read DNS Record ID "+data.Id.ValueString()+"; "+err.Error(),
+		)
+		return
+	}
+
+	mapDnsRecordResourceJson(*record, &data)
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *record)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update DNS Record")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_dns_record.DnsRecordModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := readForUpdate(ctx, r.client, req.Private, "DNS Record", func() (*dnsRecord, error) {
+		return r.client.api.getDNSRecord(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	mapDnsRecordResourceModel(data, &body)
+	record, err := r.client.api.updateDNSRecord(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating DNS Record",
+			"Could not update DNS Record, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapDnsRecordResourceJson(*record, &data)
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *record)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dnsRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete DNS Record")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_dns_record.DnsRecordModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing DNS Record
+	err := r.client.api.deleteDNSRecord(ctx, data.Site.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting DNS Record",
+			"Could not delete DNS Record, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_dns_record"
+)
+
+func TestDnsRecordResourceMapping(t *testing.T) {
+	model := resource_dns_record.DnsRecordModel{
+		Name:     types.StringValue("_sip._tcp.home.arpa"),
+		Type:     types.StringValue("SRV"),
+		Value:    types.StringValue("pbx.home.arpa"),
+		Ttl:      types.Int64Value(3600),
+		Priority: types.Int64Value(10),
+		Weight:   types.Int64Value(5),
+		Port:     types.Int64Value(5060),
+		Enabled:  types.BoolValue(true),
+	}
+
+	var body dnsRecord
+	mapDnsRecordResourceModel(model, &body)
+	assert.Equal(t, dnsRecord{
+		Key:        "_sip._tcp.home.arpa",
+		RecordType: "SRV",
+		Value:      "pbx.home.arpa",
+		Ttl:        3600,
+		Priority:   10,
+		Weight:     5,
+		Port:       5060,
+		Enabled:    true,
+	}, body)
+
+	var state resource_dns_record.DnsRecordModel
+	mapDnsRecordResourceJson(body, &state)
+	assert.Equal(t, model.Name, state.Name)
+	assert.Equal(t, model.Type, state.Type)
+	assert.Equal(t, model.Value, state.Value)
+	assert.Equal(t, model.Ttl, state.Ttl)
+	assert.Equal(t, model.Priority, state.Priority)
+	assert.Equal(t, model.Weight, state.Weight)
+	assert.Equal(t, model.Port, state.Port)
+	assert.Equal(t, model.Enabled, state.Enabled)
+
+	// Unknown service fields keep the values of the current record.
+	model.Priority = types.Int64Unknown()
+	model.Weight = types.Int64Unknown()
+	model.Port = types.Int64Value(5061)
+	mapDnsRecordResourceModel(model, &body)
+	assert.Equal(t, 10, body.Priority)
+	assert.Equal(t, 5, body.Weight)
+	assert.Equal(t, 5061, body.Port)
+}
+
+func TestDnsRecord_API(t *testing.T) {
+	ctx := context.Background()
+	client := newAPITestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/api/site/default/static-dns":
+			_, _ = w.Write([]byte(`[{"_id":"dns-1","key":"nas.home.arpa","record_type":"A","value":"192.168.1.10","enabled":true}]`))
+		case "PUT /v2/api/site/default/static-dns/dns-1":
+			var record dnsRecord
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&record))
+			_ = json.NewEncoder(w).Encode(record)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	record, err := client.getDNSRecord(ctx, "default", "dns-1")
+	assert.NoError(t, err)
+	assert.Equal(t, &dnsRecord{ID: "dns-1", Key: "nas.home.arpa", RecordType: "A", Value: "192.168.1.10", Enabled: true}, record)
+
+	_, err = client.getDNSRecord(ctx, "default", "dns-2")
+	assert.ErrorContains(t, err, "DNS record dns-2 not found")
+
+	record.Value = "192.168.1.11"
+	updated, err := client.updateDNSRecord(ctx, "default", record)
+	assert.NoError(t, err)
+	assert.Equal(t, record, updated)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/datasource_dns_records"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var (
+	_ datasource.DataSource = &dnsRecordsDataSource{}
+)
+
+func NewDnsRecordsDataSource() datasource.DataSource {
+	return &dnsRecordsDataSource{}
+}
+
+type dnsRecordsDataSource struct {
+	client *unifiClient
+}
+
+func (d *dnsRecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_records"
+}
+
+func (d *dnsRecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_dns_records.DnsRecordsDataSourceSchema(ctx)
+}
+
+func (d *dnsRecordsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dnsRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_dns_records.DnsRecordsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get DNS Records
+	dnsRecords, err := d.client.api.listDNSRecords(ctx, data.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read DNS Records",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseDnsRecordsDataSourceJson(ctx, dnsRecords, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func parseDnsRecordsDataSourceJson(ctx context.Context, json []dnsRecord, model *datasource_dns_records.DnsRecordsModel) diag.Diagnostics {
+	dnsRecordsList, diags := objectListValue(ctx, datasource_dns_records.DnsRecordsValue{}.Type(ctx), json, mapDnsRecordsDataSourceJson)
+	if diags.HasError() {
+		return diags
+	}
+	model.DnsRecords = dnsRecordsList
+
+	return nil
+}
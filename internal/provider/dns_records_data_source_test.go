@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/datasource_dns_records"
+)
+
+func TestDnsRecordsDataSourceJson(t *testing.T) {
+	ctx := context.Background()
+
+	var model datasource_dns_records.DnsRecordsModel
+	diags := parseDnsRecordsDataSourceJson(ctx, []dnsRecord{
+		{ID: "rec-1", Key: "nas.home.arpa", RecordType: "A", Value: "192.168.1.20", Enabled: true},
+		{ID: "rec-2", Key: "_sip._tcp.home.arpa", RecordType: "SRV", Value: "pbx.home.arpa", Ttl: 3600, Priority: 10, Weight: 5, Port: 5060},
+	}, &model)
+	assert.False(t, diags.HasError(), diags)
+
+	var records []datasource_dns_records.DnsRecordsValue
+	assert.False(t, model.DnsRecords.ElementsAs(ctx, &records, false).HasError())
+	assert.Equal(t, 2, len(records))
+	assert.Equal(t, types.StringValue("rec-1"), records[0].Id)
+	assert.Equal(t, types.StringValue("nas.home.arpa"), records[0].Name)
+	assert.Equal(t, types.StringValue("A"), records[0].DnsRecordsType)
+	assert.Equal(t, types.StringValue("192.168.1.20"), records[0].Value)
+	assert.Equal(t, types.BoolValue(true), records[0].Enabled)
+	assert.Equal(t, types.StringValue("SRV"), records[1].DnsRecordsType)
+	assert.Equal(t, types.Int64Value(3600), records[1].Ttl)
+	assert.Equal(t, types.Int64Value(10), records[1].Priority)
+	assert.Equal(t, types.Int64Value(5), records[1].Weight)
+	assert.Equal(t, types.Int64Value(5060), records[1].Port)
+	assert.Equal(t, types.BoolValue(false), records[1].Enabled)
+
+	// A site without records is an empty list, not null.
+	diags = parseDnsRecordsDataSourceJson(ctx, nil, &model)
+	assert.False(t, diags.HasError(), diags)
+	assert.False(t, model.DnsRecords.IsNull())
+	assert.Equal(t, 0, len(model.DnsRecords.Elements()))
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/datasource_dpi_apps"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var (
+	_ datasource.DataSource = &dpiAppsDataSource{}
+)
+
+func NewDpiAppsDataSource() datasource.DataSource {
+	return &dpiAppsDataSource{}
+}
+
+type dpiAppsDataSource struct {
+	client *unifiClient
+}
+
+func (d *dpiAppsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dpi_apps"
+}
+
+func (d *dpiAppsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_dpi_apps.DpiAppsDataSourceSchema(ctx)
+}
+
+func (d *dpiAppsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dpiAppsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_dpi_apps.DpiAppsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the catalog of apps
+	dpiApps, err := d.client.api.listDPIApps(ctx, data.Site.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read DPI apps",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseDpiAppsDataSourceJson(ctx, dpiApps, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func parseDpiAppsDataSourceJson(ctx context.Context, json []dpiApp, model *datasource_dpi_apps.DpiAppsModel) diag.Diagnostics {
+	dpiAppsList, diags := objectListValue(ctx, datasource_dpi_apps.DpiAppsValue{}.Type(ctx), json, mapDpiAppsDataSourceJson)
+	if diags.HasError() {
+		return diags
+	}
+	model.DpiApps = dpiAppsList
+
+	return nil
+}
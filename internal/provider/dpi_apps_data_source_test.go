@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/datasource_dpi_apps"
+)
+
+func TestDpiAppsDataSourceJson(t *testing.T) {
+	ctx := context.Background()
+
+	var model datasource_dpi_apps.DpiAppsModel
+	diags := parseDpiAppsDataSourceJson(ctx, []dpiApp{
+		{ID: 589885, Name: "YouTube", CategoryID: 4, CategoryName: "Media streaming services"},
+		{ID: 655390, Name: "Netflix", CategoryID: 4, CategoryName: "Media streaming services"},
+	}, &model)
+	assert.False(t, diags.HasError(), diags)
+
+	var apps []datasource_dpi_apps.DpiAppsValue
+	assert.False(t, model.DpiApps.ElementsAs(ctx, &apps, false).HasError())
+	assert.Equal(t, 2, len(apps))
+	assert.Equal(t, types.Int64Value(589885), apps[0].Id)
+	assert.Equal(t, types.StringValue("YouTube"), apps[0].Name)
+	assert.Equal(t, types.Int64Value(4), apps[0].CategoryId)
+	assert.Equal(t, types.StringValue("Media streaming services"), apps[0].CategoryName)
+	assert.Equal(t, types.StringValue("Netflix"), apps[1].Name)
+
+	// An empty catalog is an empty list, not null.
+	diags = parseDpiAppsDataSourceJson(ctx, nil, &model)
+	assert.False(t, diags.HasError(), diags)
+	assert.False(t, model.DpiApps.IsNull())
+	assert.Equal(t, 0, len(model.DpiApps.Elements()))
+}
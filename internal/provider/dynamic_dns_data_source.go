@@ -10,7 +10,6 @@ import (
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_dynamic_dns"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -73,18 +72,8 @@ func (d *dynamicDnsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	parseDynamicDnsDataSourceJson(*dynamicDns, &data)
+	mapDynamicDnsDataSourceJson(*dynamicDns, &data)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-func parseDynamicDnsDataSourceJson(json unifi.DynamicDNS, model *datasource_dynamic_dns.DynamicDnsModel) {
-	model.Id = types.StringValue(json.ID)
-	model.HostName = types.StringValue(json.HostName)
-	model.Interface = types.StringValue(json.Interface)
-	model.Login = types.StringValue(json.Login)
-	model.Password = types.StringValue(json.XPassword)
-	model.Server = types.StringValue(json.Server)
-	model.Service = types.StringValue(json.Service)
-}
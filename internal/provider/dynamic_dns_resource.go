@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/zoullx/terraform-provider-unifi/internal/resource_dynamic_dns"
@@ -61,9 +60,8 @@ func (r *dynamicDnsResource) Configure(ctx context.Context, req resource.Configu
 }
 
 func (r *dynamicDnsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
 			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
@@ -71,11 +69,16 @@ func (r *dynamicDnsResource) ImportState(ctx context.Context, req resource.Impor
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (r *dynamicDnsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create Dynamic DNS")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_dynamic_dns.DynamicDnsModel
 
 	// Read Terraform plan data into the model
@@ -85,7 +88,7 @@ func (r *dynamicDnsResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	var body unifi.DynamicDNS
-	parseDynamicDnsResourceModel(data, &body)
+	mapDynamicDnsResourceModel(data, &body)
 	dynamicDns, err := r.client.CreateDynamicDNS(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -95,7 +98,7 @@ func (r *dynamicDnsResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	parseDynamicDnsResourceJson(*dynamicDns, &data)
+	mapDynamicDnsResourceJson(*dynamicDns, &data)
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	// Save data into Terraform state
@@ -121,13 +124,18 @@ func (r *dynamicDnsResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	parseDynamicDnsResourceJson(*dynamicDns, &data)
+	mapDynamicDnsResourceJson(*dynamicDns, &data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *dynamicDnsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update Dynamic DNS")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_dynamic_dns.DynamicDnsModel
 
 	// Read Terraform plan data into the model
@@ -136,8 +144,16 @@ func (r *dynamicDnsResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	var body unifi.DynamicDNS
-	parseDynamicDnsResourceModel(data, &body)
+	current, diags := readForUpdate(ctx, r.client, nil, "Dynamic DNS", func() (*unifi.DynamicDNS, error) {
+		return r.client.GetDynamicDNS(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	mapDynamicDnsResourceModel(data, &body)
 	dynamicDns, err := r.client.UpdateDynamicDNS(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -147,7 +163,7 @@ func (r *dynamicDnsResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	parseDynamicDnsResourceJson(*dynamicDns, &data)
+	mapDynamicDnsResourceJson(*dynamicDns, &data)
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	// Save updated data into Terraform state
@@ -155,6 +171,11 @@ func (r *dynamicDnsResource) Update(ctx context.Context, req resource.UpdateRequ
 }
 
 func (r *dynamicDnsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete Dynamic DNS")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_dynamic_dns.DynamicDnsModel
 
 	// Read Terraform prior state data into the model
@@ -173,23 +194,3 @@ func (r *dynamicDnsResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 }
-
-func parseDynamicDnsResourceJson(json unifi.DynamicDNS, model *resource_dynamic_dns.DynamicDnsModel) {
-	model.Id = types.StringValue(json.ID)
-	model.HostName = types.StringValue(json.HostName)
-	model.Interface = types.StringValue(json.Interface)
-	model.Login = types.StringValue(json.Login)
-	model.Password = types.StringValue(json.XPassword)
-	model.Server = types.StringValue(json.Server)
-	model.Service = types.StringValue(json.Service)
-}
-
-func parseDynamicDnsResourceModel(model resource_dynamic_dns.DynamicDnsModel, json *unifi.DynamicDNS) {
-	json.ID = model.Id.ValueString()
-	json.HostName = model.HostName.ValueString()
-	json.Interface = model.Interface.ValueString()
-	json.Login = model.HostName.ValueString()
-	json.XPassword = model.Password.ValueString()
-	json.Server = model.Server.ValueString()
-	json.Service = model.Service.ValueString()
-}
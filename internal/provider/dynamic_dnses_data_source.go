@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,7 +83,7 @@ func (d *dynamicDnsesDataSource) Read(ctx context.Context, req datasource.ReadRe
 }
 
 func parseDynamicDnsesDataSourceJson(ctx context.Context, json []unifi.DynamicDNS, model *datasource_dynamic_dnses.DynamicDnsesModel) diag.Diagnostics {
-	dynamicDnsesList, diags := types.ListValueFrom(ctx, datasource_dynamic_dnses.DynamicDnsesValue{}.Type(ctx), json)
+	dynamicDnsesList, diags := objectListValue(ctx, datasource_dynamic_dnses.DynamicDnsesValue{}.Type(ctx), json, mapDynamicDnsesDataSourceJson)
 	if diags.HasError() {
 		return diags
 	}
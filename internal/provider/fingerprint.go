@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+// fingerprintKey is the private state key holding the fingerprint of the
+// controller object as last seen by Terraform.
+const fingerprintKey = "fingerprint"
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+type fingerprintState struct {
+	SHA256 string `json:"sha256"`
+}
+
+// objectFingerprint returns a hash of the JSON document of a controller
+// object. Only the fields of the API type are covered, so a change to a
+// setting the type doesn't model doesn't change the fingerprint.
+func objectFingerprint(object any) (string, error) {
+	b, err := json.Marshal(object)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// setFingerprint records the fingerprint of object in the private state of
+// the resource. It must be called by Create, Read and Update with the object
+// returned by the controller.
+func setFingerprint(ctx context.Context, private privateStateSetter, object any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fingerprint, err := objectFingerprint(object)
+	if err != nil {
+		diags.AddError(
+			"Error Fingerprinting Object",
+			"Could not compute the fingerprint of the controller object, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	value, err := json.Marshal(fingerprintState{SHA256: fingerprint})
+	if err != nil {
+		diags.AddError(
+			"Error Fingerprinting Object",
+			"Could not encode the fingerprint of the controller object, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	return private.SetKey(ctx, fingerprintKey, value)
+}
+
+// checkFingerprint returns an error diagnostic when current, the object as
+// it is on the controller right before an update, differs from the object
+// recorded in the private state when the plan was created. name describes
+// the object, e.g. "Network". Resources without a recorded fingerprint, e.g.
+// created by an older version of the provider, are not checked.
+func checkFingerprint(ctx context.Context, client unifi.Client, private privateStateGetter, current any, name string) diag.Diagnostics {
+	if c, ok := client.(*unifiClient); ok && c.forceOverwrite {
+		return nil
+	}
+
+	value, diags := private.GetKey(ctx, fingerprintKey)
+	if diags.HasError() || len(bytes.TrimSpace(value)) == 0 {
+		return diags
+	}
+
+	var recorded fingerprintState
+	if err := json.Unmarshal(value, &recorded); err != nil || recorded.SHA256 == "" {
+		return diags
+	}
+
+	fingerprint, err := objectFingerprint(current)
+	if err != nil {
+		diags.AddError(
+			"Error Fingerprinting Object",
+			"Could not compute the fingerprint of the controller object, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	if fingerprint != recorded.SHA256 {
+		diags.AddError(
+			name+" Modified Outside Terraform",
+			"The "+name+" was modified outside Terraform since plan, e.g. in the Unifi UI. "+
+				"Applying the plan would overwrite that change. Run terraform plan again to review the current configuration, "+
+				"or set force_overwrite in the provider configuration or the UNIFI_FORCE_OVERWRITE environment variable to overwrite it.",
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestCheckFingerprint(t *testing.T) {
+	ctx := context.Background()
+	client := &unifiClient{}
+	planned := unifi.Network{ID: "net-123", Name: "LAN"}
+	modified := unifi.Network{ID: "net-123", Name: "LAN (edited in UI)"}
+
+	private := testPrivateState{}
+	assert.False(t, setFingerprint(ctx, private, planned).HasError())
+
+	assert.False(t, checkFingerprint(ctx, client, private, planned, "Network").HasError())
+
+	diags := checkFingerprint(ctx, client, private, modified, "Network")
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "modified outside Terraform since plan")
+
+	client.forceOverwrite = true
+	assert.False(t, checkFingerprint(ctx, client, private, modified, "Network").HasError())
+}
+
+func TestCheckFingerprint_NotRecorded(t *testing.T) {
+	diags := checkFingerprint(context.Background(), &unifiClient{}, testPrivateState{}, unifi.Network{Name: "LAN"}, "Network")
+
+	assert.False(t, diags.HasError())
+}
@@ -10,8 +10,6 @@ import (
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_firewall_group"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -88,7 +86,7 @@ func (d *firewallGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	resp.Diagnostics.Append(parseFirewallGroupDataSourceJson(ctx, *firewallGroup, &data)...)
+	resp.Diagnostics.Append(mapFirewallGroupDataSourceJson(ctx, *firewallGroup, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -96,18 +94,3 @@ func (d *firewallGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-func parseFirewallGroupDataSourceJson(ctx context.Context, json unifi.FirewallGroup, model *datasource_firewall_group.FirewallGroupModel) diag.Diagnostics {
-	model.Id = types.StringValue(json.ID)
-	model.SiteId = types.StringValue(json.SiteID)
-	model.Name = types.StringValue(json.Name)
-	model.Type = types.StringValue(json.GroupType)
-
-	memberList, diags := types.ListValueFrom(ctx, types.StringType, json.GroupMembers)
-	if diags.HasError() {
-		return diags
-	}
-	model.Members = memberList
-
-	return nil
-}
@@ -6,12 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/zoullx/terraform-provider-unifi/internal/resource_firewall_group"
 
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,9 +17,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &firewallGroupResource{}
-	_ resource.ResourceWithConfigure   = &firewallGroupResource{}
-	_ resource.ResourceWithImportState = &firewallGroupResource{}
+	_ resource.Resource                 = &firewallGroupResource{}
+	_ resource.ResourceWithConfigure    = &firewallGroupResource{}
+	_ resource.ResourceWithImportState  = &firewallGroupResource{}
+	_ resource.ResourceWithUpgradeState = &firewallGroupResource{}
 )
 
 func NewFirewallGroupResource() resource.Resource {
@@ -38,6 +37,11 @@ func (r *firewallGroupResource) Metadata(ctx context.Context, req resource.Metad
 
 func (r *firewallGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_firewall_group.FirewallGroupResourceSchema(ctx)
+	resp.Schema.Version = setSchemaVersion
+}
+
+func (r *firewallGroupResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return listToSetStateUpgraders(ctx, firewallGroupSchemaV0(), resource_firewall_group.FirewallGroupResourceSchema(ctx))
 }
 
 func (r *firewallGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -62,9 +66,8 @@ func (r *firewallGroupResource) Configure(ctx context.Context, req resource.Conf
 }
 
 func (r *firewallGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
 			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
@@ -72,11 +75,16 @@ func (r *firewallGroupResource) ImportState(ctx context.Context, req resource.Im
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (r *firewallGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create Firewall Group")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_firewall_group.FirewallGroupModel
 
 	// Read Terraform plan data into the model
@@ -86,7 +94,7 @@ func (r *firewallGroupResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	var body unifi.FirewallGroup
-	resp.Diagnostics.Append(parseFirewallGroupResourceModel(ctx, data, &body)...)
+	resp.Diagnostics.Append(mapFirewallGroupResourceModel(ctx, data, &body)...)
 	firewallGroup, err := r.client.CreateFirewallGroup(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -96,7 +104,7 @@ func (r *firewallGroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	resp.Diagnostics.Append(parseFirewallGroupResourceJson(ctx, *firewallGroup, &data)...)
+	resp.Diagnostics.Append(mapFirewallGroupResourceJson(ctx, *firewallGroup, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -126,7 +134,7 @@ func (r *firewallGroupResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	resp.Diagnostics.Append(parseFirewallGroupResourceJson(ctx, *firewallGroup, &data)...)
+	resp.Diagnostics.Append(mapFirewallGroupResourceJson(ctx, *firewallGroup, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -136,6 +144,11 @@ func (r *firewallGroupResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *firewallGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update Firewall Group")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_firewall_group.FirewallGroupModel
 
 	// Read Terraform plan data into the model
@@ -144,8 +157,16 @@ func (r *firewallGroupResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	var body unifi.FirewallGroup
-	resp.Diagnostics.Append(parseFirewallGroupResourceModel(ctx, data, &body)...)
+	current, diags := readForUpdate(ctx, r.client, nil, "Firewall Group", func() (*unifi.FirewallGroup, error) {
+		return r.client.GetFirewallGroup(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	resp.Diagnostics.Append(mapFirewallGroupResourceModel(ctx, data, &body)...)
 	firewallGroup, err := r.client.UpdateFirewallGroup(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -155,7 +176,7 @@ func (r *firewallGroupResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	resp.Diagnostics.Append(parseFirewallGroupResourceJson(ctx, *firewallGroup, &data)...)
+	resp.Diagnostics.Append(mapFirewallGroupResourceJson(ctx, *firewallGroup, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -167,6 +188,11 @@ func (r *firewallGroupResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *firewallGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete Firewall Group")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_firewall_group.FirewallGroupModel
 
 	// Read Terraform prior state data into the model
@@ -185,34 +211,3 @@ func (r *firewallGroupResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 }
-
-func parseFirewallGroupResourceJson(ctx context.Context, json unifi.FirewallGroup, model *resource_firewall_group.FirewallGroupModel) diag.Diagnostics {
-	model.Id = types.StringValue(json.ID)
-	model.SiteId = types.StringValue(json.SiteID)
-	model.Name = types.StringValue(json.Name)
-	model.Type = types.StringValue(json.GroupType)
-
-	memberList, diags := types.ListValueFrom(ctx, types.StringType, json.GroupMembers)
-	if diags.HasError() {
-		return diags
-	}
-	model.Members = memberList
-
-	return nil
-}
-
-func parseFirewallGroupResourceModel(ctx context.Context, model resource_firewall_group.FirewallGroupModel, json *unifi.FirewallGroup) diag.Diagnostics {
-	json.ID = model.Id.ValueString()
-	json.SiteID = model.SiteId.ValueString()
-	json.Name = model.Name.ValueString()
-	json.GroupType = model.Type.ValueString()
-
-	if !model.Members.IsUnknown() && !model.Members.IsNull() {
-		diags := model.Members.ElementsAs(ctx, &json.GroupMembers, false)
-		if diags.HasError() {
-			return diags
-		}
-	}
-
-	return nil
-}
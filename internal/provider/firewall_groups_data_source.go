@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,7 +83,7 @@ func (d *firewallGroupsDataSource) Read(ctx context.Context, req datasource.Read
 }
 
 func parseFirewallGroupsDataSourceJson(ctx context.Context, json []unifi.FirewallGroup, model *datasource_firewall_groups.FirewallGroupsModel) diag.Diagnostics {
-	firewallGroupList, diags := types.ListValueFrom(ctx, datasource_firewall_groups.FirewallGroupsValue{}.Type(ctx), json)
+	firewallGroupList, diags := objectListValue(ctx, datasource_firewall_groups.FirewallGroupsValue{}.Type(ctx), json, mapFirewallGroupsDataSourceJson)
 	if diags.HasError() {
 		return diags
 	}
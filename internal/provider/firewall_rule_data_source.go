@@ -10,8 +10,6 @@ import (
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_firewall_rule"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -88,7 +86,7 @@ func (d *firewallRuleDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	resp.Diagnostics.Append(parseFirewallRuleDataSourceJson(ctx, *firewallRule, &data)...)
+	resp.Diagnostics.Append(mapFirewallRuleDataSourceJson(ctx, *firewallRule, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -96,52 +94,3 @@ func (d *firewallRuleDataSource) Read(ctx context.Context, req datasource.ReadRe
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-func parseFirewallRuleDataSourceJson(ctx context.Context, json unifi.FirewallRule, model *datasource_firewall_rule.FirewallRuleModel) diag.Diagnostics {
-	model.Id = types.StringValue(json.ID)
-	model.SiteId = types.StringValue(json.SiteID)
-	model.Action = types.StringValue(json.Action)
-	model.DstAddress = types.StringValue(json.DstAddress)
-	model.DstAddressIpv6 = types.StringValue(json.DstAddressIPV6)
-
-	dstFirewallGroupIdList, diags := types.ListValueFrom(ctx, types.StringType, json.DstFirewallGroupIDs)
-	if diags.HasError() {
-		return diags
-	}
-	model.DstFirewallGroupIds = dstFirewallGroupIdList
-
-	model.DstNetworkId = types.StringValue(json.DstNetworkID)
-	model.DstNetworkType = types.StringValue(json.DstNetworkType)
-	model.DstPort = types.StringValue(json.DstPort)
-	model.Enabled = types.BoolValue(json.Enabled)
-	model.IcmpTypename = types.StringValue(json.ICMPTypename)
-	model.IcmpV6Typename = types.StringValue(json.ICMPv6Typename)
-	model.IpSec = types.StringValue(json.IPSec)
-	model.Logging = types.BoolValue(json.Logging)
-	model.Name = types.StringValue(json.Name)
-	model.Protocol = types.StringValue(json.Protocol)
-	model.ProtocolV6 = types.StringValue(json.ProtocolV6)
-	model.ProtocolMatchExcepted = types.BoolValue(json.ProtocolMatchExcepted)
-	model.RuleIndex = types.Int64Value(int64(json.RuleIndex))
-	model.Ruleset = types.StringValue(json.Ruleset)
-	model.SettingPreference = types.StringValue(json.SettingPreference)
-	model.SrcAddress = types.StringValue(json.SrcAddress)
-	model.SrcAddressIpv6 = types.StringValue(json.SrcAddressIPV6)
-
-	srcFirewallGroupIdList, diags := types.ListValueFrom(ctx, types.StringType, json.SrcFirewallGroupIDs)
-	if diags.HasError() {
-		return diags
-	}
-	model.SrcFirewallGroupIds = srcFirewallGroupIdList
-
-	model.SrcMac = types.StringValue(json.SrcMACAddress)
-	model.SrcNetworkId = types.StringValue(json.SrcNetworkID)
-	model.SrcNetworkType = types.StringValue(json.SrcNetworkType)
-	model.SrcPort = types.StringValue(json.SrcPort)
-	model.StateEstablished = types.BoolValue(json.StateEstablished)
-	model.StateInvalid = types.BoolValue(json.StateInvalid)
-	model.StateNew = types.BoolValue(json.StateNew)
-	model.StateRelated = types.BoolValue(json.StateRelated)
-
-	return nil
-}
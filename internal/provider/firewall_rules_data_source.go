@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,7 +83,7 @@ func (d *firewallRulesDataSource) Read(ctx context.Context, req datasource.ReadR
 }
 
 func parseFirewallRulesDataSourceJson(ctx context.Context, json []unifi.FirewallRule, model *datasource_firewall_rules.FirewallRulesModel) diag.Diagnostics {
-	firewallRuleList, diags := types.ListValueFrom(ctx, datasource_firewall_rules.FirewallRulesValue{}.Type(ctx), json)
+	firewallRuleList, diags := objectListValue(ctx, datasource_firewall_rules.FirewallRulesValue{}.Type(ctx), json, mapFirewallRulesDataSourceJson)
 	if diags.HasError() {
 		return diags
 	}
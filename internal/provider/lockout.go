@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_firewall_rule"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_network"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+const lockoutOverrideHint = "If this change is intended, run Terraform again with the UNIFI_ALLOW_LOCKOUT environment variable set to true."
+
+// lockoutAddresses returns the addresses protected by the lockout guard, or
+// nil when the guard is disabled.
+func lockoutAddresses(client unifi.Client) []netip.Addr {
+	if c, ok := client.(*unifiClient); ok {
+		return c.lockoutAddresses
+	}
+
+	return nil
+}
+
+// detectManagementAddresses returns the addresses of the Unifi Controller at
+// host and the local address used to reach it. Addresses that can't be
+// determined are skipped.
+func detectManagementAddresses(ctx context.Context, host string) []netip.Addr {
+	var addresses []netip.Addr
+
+	u, err := url.Parse(host)
+	if err != nil || u.Hostname() == "" {
+		return addresses
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "443"
+		if u.Scheme == "http" {
+			port = "80"
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	controller, err := net.DefaultResolver.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil {
+		tflog.Warn(ctx, "Unable to resolve the Unifi Controller address for the lockout guard", map[string]any{
+			"error": err.Error(),
+		})
+	}
+	for _, addr := range controller {
+		addresses = append(addresses, addr.Unmap())
+	}
+
+	// Connecting a UDP socket doesn't send anything, but selects the local
+	// address used to reach the controller.
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", net.JoinHostPort(u.Hostname(), port))
+	if err != nil {
+		tflog.Warn(ctx, "Unable to determine the local address for the lockout guard", map[string]any{
+			"error": err.Error(),
+		})
+		return addresses
+	}
+	defer conn.Close()
+
+	if local, ok := conn.LocalAddr().(*net.UDPAddr); ok {
+		addresses = append(addresses, local.AddrPort().Addr().Unmap())
+	}
+
+	return addresses
+}
+
+// protectedAddressIn returns the first protected address inside prefix.
+func protectedAddressIn(addresses []netip.Addr, prefix netip.Prefix) (netip.Addr, bool) {
+	for _, addr := range addresses {
+		if prefix.Masked().Contains(addr) {
+			return addr, true
+		}
+	}
+
+	return netip.Addr{}, false
+}
+
+// parsePrefixOrAddr parses a CIDR or a single address as a prefix.
+func parsePrefixOrAddr(value string) (netip.Prefix, bool) {
+	if prefix, err := netip.ParsePrefix(value); err == nil {
+		return prefix, true
+	}
+
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), true
+}
+
+// networkLockoutDiags returns an error when the network containing a protected
+// address would be deleted, disabled or moved to another subnet or VLAN.
+// planned is nil when the network is being destroyed.
+func networkLockoutDiags(addresses []netip.Addr, prior resource_network.NetworkModel, planned *resource_network.NetworkModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	subnet, ok := parsePrefixOrAddr(prior.Subnet.ValueString())
+	if !ok {
+		return diags
+	}
+
+	addr, ok := protectedAddressIn(addresses, subnet)
+	if !ok {
+		return diags
+	}
+
+	summary := "Change Would Lock Out Management Access"
+	detail := func(change string) string {
+		return fmt.Sprintf("The Network %q contains the management address %s. %s would cut off the Unifi Controller or Terraform from the network. %s",
+			prior.Name.ValueString(), addr, change, lockoutOverrideHint)
+	}
+
+	switch {
+	case planned == nil:
+		diags.AddError(summary, detail("Deleting it"))
+	case isSet(planned.Enabled) && !planned.Enabled.ValueBool():
+		diags.AddAttributeError(path.Root("enabled"), summary, detail("Disabling it"))
+	case changed(planned.Subnet, prior.Subnet):
+		if prefix, ok := parsePrefixOrAddr(planned.Subnet.ValueString()); !ok || !prefix.Masked().Contains(addr) {
+			diags.AddAttributeError(path.Root("subnet"), summary, detail("Moving it to the subnet "+planned.Subnet.ValueString()))
+		}
+	}
+
+	if planned != nil && isSet(planned.VlanId) && isSet(planned.VlanEnabled) &&
+		(!planned.VlanId.Equal(prior.VlanId) || !planned.VlanEnabled.Equal(prior.VlanEnabled)) {
+		diags.AddAttributeError(path.Root("vlan_id"), summary, detail("Moving it to another VLAN"))
+	}
+
+	return diags
+}
+
+// firewallRuleEndpoint is the source or destination of a firewall rule.
+type firewallRuleEndpoint struct {
+	name        string
+	addresses   []types.String
+	mac         customtypes.MacAddress
+	networkID   types.String
+	networkType types.String
+	groupIDs    types.Set
+}
+
+func firewallRuleSource(rule resource_firewall_rule.FirewallRuleModel) firewallRuleEndpoint {
+	return firewallRuleEndpoint{
+		name:        "source",
+		addresses:   []types.String{rule.SrcAddress, rule.SrcAddressIpv6},
+		mac:         rule.SrcMac,
+		networkID:   rule.SrcNetworkId,
+		networkType: rule.SrcNetworkType,
+		groupIDs:    rule.SrcFirewallGroupIds,
+	}
+}
+
+func firewallRuleDestination(rule resource_firewall_rule.FirewallRuleModel) firewallRuleEndpoint {
+	return firewallRuleEndpoint{
+		name:        "destination",
+		addresses:   []types.String{rule.DstAddress, rule.DstAddressIpv6},
+		mac:         customtypes.NewMacAddressNull(),
+		networkID:   rule.DstNetworkId,
+		networkType: rule.DstNetworkType,
+		groupIDs:    rule.DstFirewallGroupIds,
+	}
+}
+
+// isUnknown reports whether the endpoint can't be known until apply, e.g.
+// when it references a network created in the same run.
+func (e firewallRuleEndpoint) isUnknown() bool {
+	for _, value := range e.addresses {
+		if value.IsUnknown() {
+			return true
+		}
+	}
+
+	return e.mac.IsUnknown() || e.networkID.IsUnknown() || e.groupIDs.IsUnknown()
+}
+
+// firewallRuleLockoutDiags returns an error when an enabled firewall rule
+// would drop or reject traffic from a protected address in a LOCAL ruleset,
+// or traffic between protected addresses in the LAN_IN ruleset. rule is the
+// configuration of the firewall rule, so sources and destinations that
+// aren't configured match any address. Sources and destinations referencing
+// networks, firewall groups or clients of site are looked up on the
+// controller.
+func firewallRuleLockoutDiags(ctx context.Context, client unifi.Client, addresses []netip.Addr, site string, rule resource_firewall_rule.FirewallRuleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(addresses) == 0 || (isSet(rule.Enabled) && !rule.Enabled.ValueBool()) {
+		return diags
+	}
+
+	action := rule.Action.ValueString()
+	ruleset := rule.Ruleset.ValueString()
+	if (action != "drop" && action != "reject") || (ruleset != "LAN_IN" && !strings.HasSuffix(ruleset, "_LOCAL")) {
+		return diags
+	}
+
+	// LOCAL rulesets only match traffic to the gateway itself, so only the
+	// source decides whether management traffic is dropped.
+	endpoints := []firewallRuleEndpoint{firewallRuleSource(rule)}
+	if ruleset == "LAN_IN" {
+		endpoints = append(endpoints, firewallRuleDestination(rule))
+	}
+
+	// Endpoints that can't be known until apply can't contain the management
+	// addresses yet.
+	for _, endpoint := range endpoints {
+		if endpoint.isUnknown() {
+			return diags
+		}
+	}
+
+	var matched []netip.Addr
+	for _, endpoint := range endpoints {
+		prefixes, d := firewallRuleEndpointPrefixes(ctx, client, site, rule.Name.ValueString(), endpoint)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		addr, ok := netip.Addr{}, false
+		for _, prefix := range prefixes {
+			if addr, ok = protectedAddressIn(addresses, prefix); ok {
+				break
+			}
+		}
+		if !ok {
+			return diags
+		}
+		matched = append(matched, addr)
+	}
+
+	diags.AddAttributeError(
+		path.Root("action"),
+		"Firewall Rule Would Lock Out Management Access",
+		fmt.Sprintf("The Firewall Rule %q would %s traffic from the management address %s in the %s ruleset, "+
+			"cutting off the Unifi Controller or Terraform from the network. %s",
+			rule.Name.ValueString(), action, matched[0], ruleset, lockoutOverrideHint),
+	)
+
+	return diags
+}
+
+// firewallRuleEndpointPrefixes returns the addresses matched by the source or
+// destination of the firewall rule named ruleName. An endpoint that isn't
+// configured matches any address.
+func firewallRuleEndpointPrefixes(ctx context.Context, client unifi.Client, site, ruleName string, endpoint firewallRuleEndpoint) ([]netip.Prefix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var prefixes []netip.Prefix
+	anyAddress := true
+
+	for _, address := range endpoint.addresses {
+		if address.ValueString() == "" {
+			continue
+		}
+		anyAddress = false
+		if prefix, ok := parsePrefixOrAddr(address.ValueString()); ok {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	if mac := endpoint.mac.ValueNormalized(); mac != "" {
+		anyAddress = false
+
+		// The rule matches a client, which is looked up by MAC address to
+		// check the addresses it's known by.
+		clients := clientPrefixes(ctx, client, site, mac)
+		if len(clients) == 0 {
+			diags.AddAttributeWarning(
+				path.Root("src_mac"),
+				"Lockout Guard Can't Evaluate Firewall Rule",
+				fmt.Sprintf("The Firewall Rule %q matches traffic from %s, which isn't a client with a known IP address on the controller, "+
+					"so the lockout guard can't check whether the rule would cut off the Unifi Controller or Terraform from the network.",
+					ruleName, mac),
+			)
+		}
+		prefixes = append(prefixes, clients...)
+	}
+
+	if networkID := endpoint.networkID.ValueString(); networkID != "" {
+		anyAddress = false
+
+		network, err := client.GetNetwork(ctx, site, networkID)
+		if err != nil {
+			tflog.Warn(ctx, "Unable to read the "+endpoint.name+" Network for the lockout guard", map[string]any{
+				"error": err.Error(),
+			})
+		} else if prefix, ok := parsePrefixOrAddr(network.IPSubnet); ok {
+			// ADDRv4 only matches the gateway address of the network.
+			if endpoint.networkType.ValueString() == "ADDRv4" {
+				prefix = netip.PrefixFrom(prefix.Addr(), prefix.Addr().BitLen())
+			}
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	if !endpoint.groupIDs.IsNull() {
+		var groupIDs []string
+		diags.Append(endpoint.groupIDs.ElementsAs(ctx, &groupIDs, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, groupID := range groupIDs {
+			anyAddress = false
+
+			group, err := client.GetFirewallGroup(ctx, site, groupID)
+			if err != nil {
+				tflog.Warn(ctx, "Unable to read the "+endpoint.name+" Firewall Group for the lockout guard", map[string]any{
+					"error": err.Error(),
+				})
+				continue
+			}
+
+			for _, member := range group.GroupMembers {
+				if prefix, ok := parsePrefixOrAddr(member); ok {
+					prefixes = append(prefixes, prefix)
+				}
+			}
+		}
+	}
+
+	if anyAddress {
+		return []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0"), netip.MustParsePrefix("::/0")}, diags
+	}
+
+	return prefixes, diags
+}
+
+// clientPrefixes returns the current and fixed IP addresses of the client
+// of site with the normalized MAC address mac.
+func clientPrefixes(ctx context.Context, client unifi.Client, site, mac string) []netip.Prefix {
+	users, err := client.ListUser(ctx, site)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read the source Client for the lockout guard", map[string]any{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	var prefixes []netip.Prefix
+	for _, user := range users {
+		if normalized, err := customtypes.NormalizeMacAddress(user.MAC); err != nil || normalized != mac {
+			continue
+		}
+
+		for _, address := range []string{user.IP, user.FixedIP} {
+			if prefix, ok := parsePrefixOrAddr(address); ok {
+				prefixes = append(prefixes, prefix)
+			}
+		}
+	}
+
+	return prefixes
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_firewall_rule"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_network"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+type lockoutTestClient struct {
+	unifi.Client
+
+	networks map[string]*unifi.Network
+	groups   map[string]*unifi.FirewallGroup
+	users    []unifi.User
+}
+
+func (c *lockoutTestClient) ListUser(_ context.Context, _ string) ([]unifi.User, error) {
+	return c.users, nil
+}
+
+func (c *lockoutTestClient) GetNetwork(_ context.Context, _, id string) (*unifi.Network, error) {
+	return c.networks[id], nil
+}
+
+func (c *lockoutTestClient) GetFirewallGroup(_ context.Context, _, id string) (*unifi.FirewallGroup, error) {
+	return c.groups[id], nil
+}
+
+var testLockoutAddresses = []netip.Addr{netip.MustParseAddr("192.168.1.10")}
+
+func TestNetworkLockoutDiags(t *testing.T) {
+	prior := resource_network.NetworkModel{
+		Name:        types.StringValue("Management"),
+		Enabled:     types.BoolValue(true),
+		Subnet:      customtypes.NewIPPrefixValue("192.168.1.1/24"),
+		VlanEnabled: types.BoolValue(false),
+		VlanId:      types.Int64Value(0),
+	}
+
+	// Networks without a management address can be changed freely.
+	other := prior
+	other.Subnet = customtypes.NewIPPrefixValue("10.0.0.1/24")
+	assert.False(t, networkLockoutDiags(testLockoutAddresses, other, nil).HasError())
+
+	assert.True(t, networkLockoutDiags(testLockoutAddresses, prior, nil).HasError())
+
+	planned := prior
+	planned.Name = types.StringValue("Renamed")
+	assert.False(t, networkLockoutDiags(testLockoutAddresses, prior, &planned).HasError())
+
+	planned = prior
+	planned.Enabled = types.BoolValue(false)
+	assert.True(t, networkLockoutDiags(testLockoutAddresses, prior, &planned).HasError())
+
+	planned = prior
+	planned.Subnet = customtypes.NewIPPrefixValue("192.168.1.1/23")
+	assert.False(t, networkLockoutDiags(testLockoutAddresses, prior, &planned).HasError())
+	planned.Subnet = customtypes.NewIPPrefixValue("192.168.2.1/24")
+	assert.True(t, networkLockoutDiags(testLockoutAddresses, prior, &planned).HasError())
+
+	planned = prior
+	planned.VlanEnabled = types.BoolValue(true)
+	planned.VlanId = types.Int64Value(10)
+	assert.True(t, networkLockoutDiags(testLockoutAddresses, prior, &planned).HasError())
+}
+
+func TestFirewallRuleLockoutDiags(t *testing.T) {
+	ctx := context.Background()
+	client := &lockoutTestClient{
+		networks: map[string]*unifi.Network{
+			"mgmt": {ID: "mgmt", IPSubnet: "192.168.1.1/24"},
+			"iot":  {ID: "iot", IPSubnet: "10.0.20.1/24"},
+		},
+		groups: map[string]*unifi.FirewallGroup{
+			"admins": {ID: "admins", GroupMembers: []string{"192.168.1.10"}},
+		},
+		users: []unifi.User{
+			{MAC: "00:11:22:33:44:55", IP: "192.168.1.10"},
+			{MAC: "00:11:22:33:44:66", FixedIP: "10.0.20.5"},
+		},
+	}
+	rule := func() resource_firewall_rule.FirewallRuleModel {
+		return resource_firewall_rule.FirewallRuleModel{
+			Name:                types.StringValue("Block"),
+			Action:              types.StringValue("drop"),
+			Ruleset:             types.StringValue("LAN_IN"),
+			SrcFirewallGroupIds: types.SetNull(types.StringType),
+		}
+	}
+
+	// Any source.
+	assert.True(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", rule()).HasError())
+
+	allowed := rule()
+	allowed.Action = types.StringValue("accept")
+	assert.False(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", allowed).HasError())
+
+	wan := rule()
+	wan.Ruleset = types.StringValue("WAN_IN")
+	assert.False(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", wan).HasError())
+
+	local := rule()
+	local.Ruleset = types.StringValue("LAN_LOCAL")
+	local.SrcAddress = types.StringValue("192.168.1.0/24")
+	assert.True(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", local).HasError())
+
+	// LAN_IN rules only lock out management traffic going to a protected
+	// address, LOCAL rules match any traffic to the gateway.
+	destination := rule()
+	destination.DstNetworkId = types.StringValue("iot")
+	assert.False(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", destination).HasError())
+	destination.DstNetworkId = types.StringValue("mgmt")
+	assert.True(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", destination).HasError())
+	destination.DstNetworkId = types.StringNull()
+	destination.DstAddress = types.StringValue("10.0.20.0/24")
+	assert.False(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", destination).HasError())
+	destination.DstAddress = types.StringNull()
+	destination.DstFirewallGroupIds = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("admins")})
+	assert.True(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", destination).HasError())
+	destination.DstFirewallGroupIds = types.SetUnknown(types.StringType)
+	assert.False(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", destination).HasError())
+
+	local.DstNetworkId = types.StringValue("iot")
+	assert.True(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", local).HasError())
+
+	network := rule()
+	network.SrcNetworkId = types.StringValue("iot")
+	assert.False(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", network).HasError())
+	network.SrcNetworkId = types.StringValue("mgmt")
+	assert.True(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", network).HasError())
+	network.SrcNetworkType = types.StringValue("ADDRv4")
+	assert.False(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", network).HasError())
+
+	group := rule()
+	group.SrcFirewallGroupIds = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("admins")})
+	assert.True(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", group).HasError())
+
+	mac := rule()
+	mac.SrcMac = customtypes.NewMacAddressValue("00-11-22-33-44-55")
+	assert.True(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", mac).HasError())
+	mac.SrcMac = customtypes.NewMacAddressValue("00:11:22:33:44:66")
+	diags := firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", mac)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 0, diags.WarningsCount())
+
+	// Clients without a known address can't be checked.
+	mac.SrcMac = customtypes.NewMacAddressValue("00:11:22:33:44:77")
+	diags = firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", mac)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, diags.WarningsCount())
+
+	disabled := rule()
+	disabled.Enabled = types.BoolValue(false)
+	assert.False(t, firewallRuleLockoutDiags(ctx, client, testLockoutAddresses, "default", disabled).HasError())
+
+	assert.False(t, firewallRuleLockoutDiags(ctx, client, nil, "default", rule()).HasError())
+}
+
+func TestEnvBool_AllowLockout(t *testing.T) {
+	t.Setenv("UNIFI_ALLOW_LOCKOUT", "maybe")
+
+	var diags diag.Diagnostics
+	assert.False(t, envBool(&diags, "", "UNIFI_ALLOW_LOCKOUT"))
+	assert.Equal(t, 1, diags.ErrorsCount())
+
+	// UNIFI_ALLOW_LOCKOUT has no attribute to report the error against.
+	_, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+	assert.False(t, ok)
+
+	t.Setenv("UNIFI_ALLOW_LOCKOUT", "true")
+	diags = nil
+	assert.True(t, envBool(&diags, "", "UNIFI_ALLOW_LOCKOUT"))
+	assert.False(t, diags.HasError())
+}
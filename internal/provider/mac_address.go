@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// normalizedMacAddresses returns the elements of a set of MAC addresses in
+// the format used by the controller.
+func normalizedMacAddresses(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	var macs []customtypes.MacAddress
+
+	diags := set.ElementsAs(ctx, &macs, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	normalized := make([]string, 0, len(macs))
+	for _, mac := range macs {
+		normalized = append(normalized, mac.ValueNormalized())
+	}
+
+	return normalized, diags
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// The map*Json and map*Model functions in the *_mapping_gen.go files are
+// generated from generate/mapping-spec.json, see tools/mappinggen. The
+// helpers below are used by the generated code.
+
+// compactStrings returns the non-empty values, or nil if all are empty. The
+// API stores some lists, e.g. DNS servers, in numbered fields.
+func compactStrings(values ...string) []string {
+	var compacted []string
+	for _, value := range values {
+		if value != "" {
+			compacted = append(compacted, value)
+		}
+	}
+
+	return compacted
+}
+
+// stringAtIndex returns the value at index i, or an empty string if there
+// are fewer values.
+func stringAtIndex(values []string, i int) string {
+	if i < len(values) {
+		return values[i]
+	}
+
+	return ""
+}
+
+// objectListValue converts items to a list of elemType, an object type of
+// the generated schemas, e.g. the items of a plural data source. The
+// attributes of each object are set by setAttributes, usually a generated
+// map*DataSourceJson function.
+func objectListValue[T any](ctx context.Context, elemType attr.Type, items []T, setAttributes func(context.Context, T, map[string]attr.Value) diag.Diagnostics) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objectType, ok := elemType.(interface {
+		basetypes.ObjectTypable
+		AttributeTypes() map[string]attr.Type
+	})
+	if !ok {
+		diags.AddError(
+			"Object List Conversion Error",
+			fmt.Sprintf("An unexpected error was encountered converting a list. Expected an object element type, got %T.", elemType),
+		)
+		return types.ListNull(elemType), diags
+	}
+
+	elements := make([]attr.Value, 0, len(items))
+	for _, item := range items {
+		attributes := map[string]attr.Value{}
+		diags.Append(setAttributes(ctx, item, attributes)...)
+		if diags.HasError() {
+			return types.ListNull(elemType), diags
+		}
+
+		object, d := types.ObjectValue(objectType.AttributeTypes(), attributes)
+		diags.Append(d...)
+		if diags.HasError() {
+			return types.ListNull(elemType), diags
+		}
+
+		element, d := objectType.ValueFromObject(ctx, object)
+		diags.Append(d...)
+		if diags.HasError() {
+			return types.ListNull(elemType), diags
+		}
+
+		elements = append(elements, element)
+	}
+
+	list, d := types.ListValue(elemType, elements)
+	diags.Append(d...)
+
+	return list, diags
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/datasource_network"
+	"github.com/zoullx/terraform-provider-unifi/internal/datasource_networks"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_network"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+func TestNetworkMapping_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	dns, diags := types.ListValueFrom(ctx, types.StringType, []string{"10.0.0.2", "10.0.0.3"})
+	assert.False(t, diags.HasError())
+
+	model := resource_network.NetworkModel{
+		Name:                  types.StringValue("lan"),
+		DhcpEnabled:           types.BoolValue(false),
+		DhcpDnsEnabled:        types.BoolValue(true),
+		DhcpDns:               dns,
+		DhcpLeaseTime:         types.Int64Value(86400),
+		Ipv6SettingPreference: types.StringValue("manual"),
+		SettingPreference:     types.StringValue("auto"),
+	}
+
+	var body unifi.Network
+	diags = parseNetworkResourceModel(ctx, model, &body)
+	assert.False(t, diags.HasError(), diags)
+	assert.False(t, body.DHCPDEnabled)
+	assert.True(t, body.DHCPDDNSEnabled)
+	assert.Equal(t, "10.0.0.2", body.DHCPDDNS1)
+	assert.Equal(t, "10.0.0.3", body.DHCPDDNS2)
+	assert.Equal(t, "", body.DHCPDDNS3)
+	assert.Equal(t, 86400, body.DHCPDLeaseTime)
+	assert.Equal(t, "manual", body.IPV6SettingPreference)
+	assert.Equal(t, "auto", body.SettingPreference)
+
+	var state resource_network.NetworkModel
+	diags = parseNetworkResourceJson(ctx, body, &state)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, types.BoolValue(false), state.DhcpEnabled)
+	assert.Equal(t, types.BoolValue(true), state.DhcpDnsEnabled)
+	assert.Equal(t, dns, state.DhcpDns)
+	assert.True(t, state.WanDns.IsNull())
+
+	var dataSource datasource_network.NetworkModel
+	diags = parseNetworkDataSourceJson(ctx, body, &dataSource)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, types.BoolValue(true), dataSource.DhcpDnsEnabled)
+	assert.Equal(t, dns, dataSource.DhcpDns)
+}
+
+func TestNetworksMapping(t *testing.T) {
+	ctx := context.Background()
+
+	var model datasource_networks.NetworksModel
+	diags := parseNetworksDataSourceJson(ctx, []unifi.Network{
+		{ID: "1", Name: "lan", DHCPDDNSEnabled: true, DHCPDDNS1: "10.0.0.2"},
+		{ID: "2", Name: "guest", IPSubnet: "10.0.2.1/24"},
+	}, &model)
+	assert.False(t, diags.HasError(), diags)
+
+	var networks []datasource_networks.NetworksValue
+	assert.False(t, model.Networks.ElementsAs(ctx, &networks, false).HasError())
+	assert.Equal(t, 2, len(networks))
+	assert.Equal(t, types.StringValue("lan"), networks[0].Name)
+	assert.Equal(t, types.BoolValue(true), networks[0].DhcpDnsEnabled)
+	assert.Equal(t, 1, len(networks[0].DhcpDns.Elements()))
+	assert.Equal(t, types.StringValue("guest"), networks[1].Name)
+	assert.Equal(t, "10.0.2.1/24", networks[1].Subnet.ValueString())
+}
+
+func TestCompactStrings(t *testing.T) {
+	assert.Equal(t, []string{"a", "c"}, compactStrings("a", "", "c", ""))
+	assert.Nil(t, compactStrings("", ""))
+
+	assert.Equal(t, "c", stringAtIndex([]string{"a", "c"}, 1))
+	assert.Equal(t, "", stringAtIndex([]string{"a", "c"}, 2))
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+)
+
+// networkConf is a network of the networkconf endpoint with the settings of
+// the unifi_wan and unifi_vpn_* resources, which unifi.Network doesn't model. The settings
+// networkConf doesn't model either are kept in other and sent back
+// unchanged, so an update never drops them.
+type networkConf struct {
+	ID      string `json:"_id,omitempty"`
+	SiteID  string `json:"site_id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Purpose string `json:"purpose,omitempty"`
+	Enabled bool   `json:"enabled"`
+	VPNType string `json:"vpn_type,omitempty"`
+
+	IPSubnet        string `json:"ip_subnet,omitempty"`
+	DHCPDDNS1       string `json:"dhcpd_dns_1,omitempty"`
+	DHCPDDNS2       string `json:"dhcpd_dns_2,omitempty"`
+	DHCPDDNS3       string `json:"dhcpd_dns_3,omitempty"`
+	DHCPDDNS4       string `json:"dhcpd_dns_4,omitempty"`
+	DHCPDDNSEnabled bool   `json:"dhcpd_dns_enabled"`
+	LocalPort       int    `json:"local_port,omitempty"`
+
+	WANNetworkGroup      string   `json:"wan_networkgroup,omitempty"`
+	WANType              string   `json:"wan_type,omitempty"`
+	WANIP                string   `json:"wan_ip,omitempty"`
+	WANNetmask           string   `json:"wan_netmask,omitempty"`
+	WANGateway           string   `json:"wan_gateway,omitempty"`
+	WANUsername          string   `json:"wan_username,omitempty"`
+	XWANPassword         string   `json:"x_wan_password,omitempty"`
+	WANVLANEnabled       bool     `json:"wan_vlan_enabled"`
+	WANVLAN              int      `json:"wan_vlan,omitempty"`
+	WANEgressQOS         int      `json:"wan_egress_qos,omitempty"`
+	WANDNSPreference     string   `json:"wan_dns_preference,omitempty"`
+	WANDNS1              string   `json:"wan_dns1,omitempty"`
+	WANDNS2              string   `json:"wan_dns2,omitempty"`
+	WANDNS3              string   `json:"wan_dns3,omitempty"`
+	WANDNS4              string   `json:"wan_dns4,omitempty"`
+	WANTypeV6            string   `json:"wan_type_v6,omitempty"`
+	WANDHCPv6PDSize      int      `json:"wan_dhcpv6_pd_size,omitempty"`
+	WANIPV6              string   `json:"wan_ipv6,omitempty"`
+	WANPrefixlen         int      `json:"wan_prefixlen,omitempty"`
+	WANGatewayV6         string   `json:"wan_gateway_v6,omitempty"`
+	WANIPV6DNSPreference string   `json:"wan_ipv6_dns_preference,omitempty"`
+	WANIPV6DNS1          string   `json:"wan_ipv6_dns1,omitempty"`
+	WANIPV6DNS2          string   `json:"wan_ipv6_dns2,omitempty"`
+	MACOverrideEnabled   bool     `json:"mac_override_enabled"`
+	MACOverride          string   `json:"mac_override,omitempty"`
+	WANSmartqEnabled     bool     `json:"wan_smartq_enabled"`
+	WANSmartqDownRate    int      `json:"wan_smartq_down_rate,omitempty"`
+	WANSmartqUpRate      int      `json:"wan_smartq_up_rate,omitempty"`
+	WANLoadBalanceType   string   `json:"wan_load_balance_type,omitempty"`
+	WANLoadBalanceWeight int      `json:"wan_load_balance_weight,omitempty"`
+	WANFailoverPriority  int      `json:"wan_failover_priority,omitempty"`
+	WANMonitors          []string `json:"wan_monitors,omitempty"`
+
+	WireguardInterface   string `json:"wireguard_interface,omitempty"`
+	WireguardLocalWANIP  string `json:"wireguard_local_wan_ip,omitempty"`
+	WireguardPublicKey   string `json:"wireguard_public_key,omitempty"`
+	XWireguardPrivateKey string `json:"x_wireguard_private_key,omitempty"`
+
+	IPSecInterface        string   `json:"ipsec_interface,omitempty"`
+	IPSecLocalIP          string   `json:"ipsec_local_ip,omitempty"`
+	IPSecPeerIP           string   `json:"ipsec_peer_ip,omitempty"`
+	IPSecTunnelIP         string   `json:"ipsec_tunnel_ip,omitempty"`
+	IPSecLocalIdentifier  string   `json:"ipsec_local_identifier,omitempty"`
+	IPSecRemoteIdentifier string   `json:"ipsec_remote_identifier,omitempty"`
+	IPSecKeyExchange      string   `json:"ipsec_key_exchange,omitempty"`
+	IPSecIkeEncryption    string   `json:"ipsec_ike_encryption,omitempty"`
+	IPSecIkeHash          string   `json:"ipsec_ike_hash,omitempty"`
+	IPSecIkeDhGroup       int      `json:"ipsec_ike_dh_group,omitempty"`
+	IPSecIkeLifetime      int      `json:"ipsec_ike_lifetime,omitempty"`
+	IPSecEspEncryption    string   `json:"ipsec_esp_encryption,omitempty"`
+	IPSecEspHash          string   `json:"ipsec_esp_hash,omitempty"`
+	IPSecEspDhGroup       int      `json:"ipsec_esp_dh_group,omitempty"`
+	IPSecEspLifetime      int      `json:"ipsec_esp_lifetime,omitempty"`
+	IPSecPfs              bool     `json:"ipsec_pfs"`
+	IPSecDynamicRouting   bool     `json:"ipsec_dynamic_routing"`
+	XIPSecPreSharedKey    string   `json:"x_ipsec_pre_shared_key,omitempty"`
+	RemoteSiteID          string   `json:"remote_site_id,omitempty"`
+	RemoteSiteSubnets     []string `json:"remote_site_subnets,omitempty"`
+
+	OpenVPNConfiguration  string `json:"openvpn_configuration,omitempty"`
+	OpenVPNUsername       string `json:"openvpn_username,omitempty"`
+	XOpenVPNPassword      string `json:"x_openvpn_password,omitempty"`
+	VPNClientDefaultRoute bool   `json:"vpn_client_default_route"`
+	VPNClientPullDNS      bool   `json:"vpn_client_pull_dns"`
+	OpenVPNInterface      string `json:"openvpn_interface,omitempty"`
+	OpenVPNLocalWANIP     string `json:"openvpn_local_wan_ip,omitempty"`
+	OpenVPNProtocol       string `json:"openvpn_protocol,omitempty"`
+
+	// other holds the settings networkConf doesn't model, by JSON key.
+	other map[string]json.RawMessage
+}
+
+// networkConfFields has the fields of networkConf without its JSON methods.
+type networkConfFields networkConf
+
+// networkConfKeys are the JSON keys of the settings modeled by networkConf.
+var networkConfKeys = jsonKeys(reflect.TypeOf(networkConfFields{}))
+
+func (n networkConf) MarshalJSON() ([]byte, error) {
+	return marshalWithOther(networkConfFields(n), n.other)
+}
+
+func (n *networkConf) UnmarshalJSON(b []byte) error {
+	other, err := unmarshalWithOther(b, (*networkConfFields)(n), networkConfKeys)
+	if err != nil {
+		return err
+	}
+	n.other = other
+
+	return nil
+}
+
+func networkConfPath(site, id string) string {
+	apiPath := "api/s/" + url.PathEscape(site) + "/rest/networkconf"
+	if id != "" {
+		apiPath += "/" + url.PathEscape(id)
+	}
+	return apiPath
+}
+
+func (c *apiClient) listNetworkConf(ctx context.Context, site string) ([]networkConf, error) {
+	return apiData[networkConf](ctx, c, http.MethodGet, networkConfPath(site, ""), nil)
+}
+
+func (c *apiClient) getNetworkConf(ctx context.Context, site, id string) (*networkConf, error) {
+	return apiObject[networkConf](ctx, c, http.MethodGet, networkConfPath(site, id), nil)
+}
+
+func (c *apiClient) createNetworkConf(ctx context.Context, site string, network *networkConf) (*networkConf, error) {
+	return apiObject[networkConf](ctx, c, http.MethodPost, networkConfPath(site, ""), network)
+}
+
+func (c *apiClient) updateNetworkConf(ctx context.Context, site string, network *networkConf) (*networkConf, error) {
+	return apiObject[networkConf](ctx, c, http.MethodPut, networkConfPath(site, network.ID), network)
+}
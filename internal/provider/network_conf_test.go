@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkConf_KeepsUnmodeledSettings(t *testing.T) {
+	ctx := context.Background()
+	client := newAPITestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/s/default/rest/networkconf/wan-123":
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"wan-123","purpose":"wan","name":"Fiber","wan_type":"dhcp","mtu":1500,"igmp_proxy_upstream":true}]}`))
+		case "PUT /api/s/default/rest/networkconf/wan-123":
+			b, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+
+			var body map[string]any
+			assert.NoError(t, json.Unmarshal(b, &body))
+			assert.Equal(t, "Fiber 2", body["name"])
+			assert.Equal(t, "dhcp", body["wan_type"])
+			assert.Equal(t, float64(1500), body["mtu"])
+			assert.Equal(t, true, body["igmp_proxy_upstream"])
+
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[` + string(b) + `]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	current, err := client.getNetworkConf(ctx, "default", "wan-123")
+	assert.NoError(t, err)
+	assert.Equal(t, "Fiber", current.Name)
+	assert.Equal(t, "dhcp", current.WANType)
+
+	body := *current
+	body.Name = "Fiber 2"
+	updated, err := client.updateNetworkConf(ctx, "default", &body)
+	assert.NoError(t, err)
+	assert.Equal(t, "Fiber 2", updated.Name)
+	assert.Equal(t, current.other, updated.other)
+
+	// The unmodeled settings are part of the fingerprint, so changing them
+	// outside Terraform is detected.
+	edited := *current
+	edited.other = map[string]json.RawMessage{"mtu": json.RawMessage(`1492`), "igmp_proxy_upstream": json.RawMessage(`true`)}
+	a, err := objectFingerprint(*current)
+	assert.NoError(t, err)
+	b, err := objectFingerprint(edited)
+	assert.NoError(t, err)
+	assert.NotEqual(t, a, b)
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_network"
+)
+
+var _ resource.ConfigValidator = networkConfigValidator{}
+
+// networkConfigValidator checks that the attributes of a unifi_network are
+// consistent with each other, so mistakes are reported at plan time instead
+// of as errors such as api.err.InvalidDHCPRange from the controller.
+type networkConfigValidator struct{}
+
+func (v networkConfigValidator) Description(_ context.Context) string {
+	return "DHCP ranges must be inside the subnet and not contain the gateway, IPv6 attributes must match " +
+		"ipv6_interface_type, WAN attributes require purpose to be wan and vlan_id requires vlan_enabled"
+}
+
+func (v networkConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v networkConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resource_network.NetworkModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateNetworkDhcpRange(data)...)
+	resp.Diagnostics.Append(validateNetworkIpv6(data)...)
+	resp.Diagnostics.Append(validateNetworkWan(data)...)
+	resp.Diagnostics.Append(validateNetworkVlan(data)...)
+}
+
+// isSet reports whether an attribute has a known, non-null value in the
+// configuration.
+func isSet(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// validateNetworkDhcpRange checks that dhcp_start and dhcp_stop are inside
+// subnet, in order, and that the range doesn't contain the gateway address,
+// i.e. the address part of subnet.
+func validateNetworkDhcpRange(data resource_network.NetworkModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Invalid addresses are reported by the attribute validators.
+	subnet, err := netip.ParsePrefix(data.Subnet.ValueString())
+	if !isSet(data.Subnet) || err != nil {
+		return diags
+	}
+
+	start, startDiags := networkDhcpBound(subnet, "dhcp_start", data.DhcpStart)
+	diags.Append(startDiags...)
+	stop, stopDiags := networkDhcpBound(subnet, "dhcp_stop", data.DhcpStop)
+	diags.Append(stopDiags...)
+
+	if !start.IsValid() || !stop.IsValid() {
+		return diags
+	}
+
+	if stop.Less(start) {
+		diags.AddAttributeError(
+			path.Root("dhcp_stop"),
+			"Invalid DHCP Range",
+			fmt.Sprintf("The dhcp_stop address %s must not be lower than the dhcp_start address %s.", stop, start),
+		)
+		return diags
+	}
+
+	gateway := subnet.Addr()
+	if !gateway.Less(start) && !stop.Less(gateway) {
+		diags.AddAttributeError(
+			path.Root("subnet"),
+			"Invalid DHCP Range",
+			fmt.Sprintf("The gateway address %s of the subnet is inside the DHCP range %s - %s. "+
+				"Change dhcp_start and dhcp_stop to exclude it.", gateway, start, stop),
+		)
+	}
+
+	return diags
+}
+
+// networkDhcpBound parses a bound of the DHCP range and checks it is inside
+// subnet. The returned address is invalid when the bound isn't set or isn't
+// usable.
+func networkDhcpBound(subnet netip.Prefix, name string, value types.String) (netip.Addr, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	addr, err := netip.ParseAddr(value.ValueString())
+	if !isSet(value) || err != nil {
+		return netip.Addr{}, diags
+	}
+
+	if !subnet.Masked().Contains(addr) {
+		diags.AddAttributeError(
+			path.Root(name),
+			"Invalid DHCP Range",
+			fmt.Sprintf("The %s address %s is not inside the subnet %s of the Network.", name, addr, subnet.Masked()),
+		)
+		return netip.Addr{}, diags
+	}
+
+	return addr, diags
+}
+
+// validateNetworkIpv6 checks that the prefix delegation and static IPv6
+// attributes are only set for the matching ipv6_interface_type.
+func validateNetworkIpv6(data resource_network.NetworkModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !isSet(data.Ipv6InterfaceType) {
+		return diags
+	}
+	interfaceType := data.Ipv6InterfaceType.ValueString()
+
+	for _, group := range []struct {
+		interfaceType string
+		attributes    map[string]attr.Value
+	}{
+		{"pd", map[string]attr.Value{
+			"ipv6_pd_interface": data.Ipv6PdInterface,
+			"ipv6_pd_prefixid":  data.Ipv6PdPrefixid,
+			"ipv6_pd_start":     data.Ipv6PdStart,
+			"ipv6_pd_stop":      data.Ipv6PdStop,
+		}},
+		{"static", map[string]attr.Value{
+			"ipv6_static_subnet": data.Ipv6StaticSubnet,
+		}},
+	} {
+		if interfaceType == group.interfaceType {
+			continue
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(group.attributes)) {
+			if isSet(group.attributes[name]) {
+				diags.AddAttributeError(
+					path.Root(name),
+					"Invalid IPv6 Configuration",
+					fmt.Sprintf("The %s attribute can only be set when ipv6_interface_type is %q, but it is %q.", name, group.interfaceType, interfaceType),
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+// validateNetworkWan checks that the WAN attributes are only set for WAN
+// networks.
+func validateNetworkWan(data resource_network.NetworkModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !isSet(data.Purpose) || data.Purpose.ValueString() == "wan" {
+		return diags
+	}
+
+	attributes := map[string]attr.Value{
+		"wan_dhcp_v6_pd_size": data.WanDhcpV6PdSize,
+		"wan_dns":             data.WanDns,
+		"wan_egress_qos":      data.WanEgressQos,
+		"wan_gateway":         data.WanGateway,
+		"wan_gateway_v6":      data.WanGatewayV6,
+		"wan_ip":              data.WanIp,
+		"wan_ipv6":            data.WanIpv6,
+		"wan_netmask":         data.WanNetmask,
+		"wan_network_group":   data.WanNetworkGroup,
+		"wan_password":        data.WanPassword,
+		"wan_prefixlen":       data.WanPrefixlen,
+		"wan_type":            data.WanType,
+		"wan_type_v6":         data.WanTypeV6,
+		"wan_username":        data.WanUsername,
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		if isSet(attributes[name]) {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid WAN Configuration",
+				fmt.Sprintf("The %s attribute can only be set when purpose is \"wan\", but it is %q.", name, data.Purpose.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
+
+// validateNetworkVlan checks that vlan_id isn't set when VLANs are disabled.
+func validateNetworkVlan(data resource_network.NetworkModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if isSet(data.VlanId) && isSet(data.VlanEnabled) && !data.VlanEnabled.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("vlan_id"),
+			"Invalid VLAN Configuration",
+			"The vlan_id attribute can only be set when vlan_enabled is true.",
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_network"
+)
+
+func TestValidateNetworkDhcpRange(t *testing.T) {
+	cases := []struct {
+		name   string
+		subnet string
+		start  string
+		stop   string
+		errors int
+	}{
+		{"valid", "192.168.1.1/24", "192.168.1.6", "192.168.1.254", 0},
+		{"start outside subnet", "192.168.1.1/24", "192.168.2.6", "192.168.1.254", 1},
+		{"both outside subnet", "192.168.1.1/24", "10.0.0.6", "10.0.0.254", 2},
+		{"reversed", "192.168.1.1/24", "192.168.1.254", "192.168.1.6", 1},
+		{"gateway in range", "192.168.1.100/24", "192.168.1.6", "192.168.1.254", 1},
+		{"gateway is start", "192.168.1.6/24", "192.168.1.6", "192.168.1.254", 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			diags := validateNetworkDhcpRange(resource_network.NetworkModel{
+				Subnet:    customtypes.NewIPPrefixValue(c.subnet),
+				DhcpStart: types.StringValue(c.start),
+				DhcpStop:  types.StringValue(c.stop),
+			})
+
+			assert.Equal(t, c.errors, diags.ErrorsCount())
+		})
+	}
+}
+
+func TestValidateNetworkDhcpRange_Unknown(t *testing.T) {
+	diags := validateNetworkDhcpRange(resource_network.NetworkModel{
+		Subnet:    customtypes.NewIPPrefixUnknown(),
+		DhcpStart: types.StringValue("10.0.0.6"),
+		DhcpStop:  types.StringValue("10.0.0.254"),
+	})
+
+	assert.False(t, diags.HasError())
+}
+
+func TestValidateNetworkIpv6(t *testing.T) {
+	model := resource_network.NetworkModel{
+		Ipv6InterfaceType: types.StringValue("pd"),
+		Ipv6PdStart:       types.StringValue("::2"),
+		Ipv6PdStop:        types.StringValue("::7d1"),
+	}
+	assert.False(t, validateNetworkIpv6(model).HasError())
+
+	model.Ipv6StaticSubnet = types.StringValue("fd00::1/64")
+	assert.Equal(t, 1, validateNetworkIpv6(model).ErrorsCount())
+
+	model.Ipv6InterfaceType = types.StringValue("none")
+	assert.Equal(t, 3, validateNetworkIpv6(model).ErrorsCount())
+}
+
+func TestValidateNetworkWan(t *testing.T) {
+	model := resource_network.NetworkModel{
+		Purpose: types.StringValue("wan"),
+		WanType: types.StringValue("dhcp"),
+	}
+	assert.False(t, validateNetworkWan(model).HasError())
+
+	model.Purpose = types.StringValue("corporate")
+	assert.Equal(t, 1, validateNetworkWan(model).ErrorsCount())
+}
+
+func TestValidateNetworkVlan(t *testing.T) {
+	model := resource_network.NetworkModel{
+		VlanId: types.Int64Value(10),
+	}
+	assert.False(t, validateNetworkVlan(model).HasError())
+
+	model.VlanEnabled = types.BoolValue(true)
+	assert.False(t, validateNetworkVlan(model).HasError())
+
+	model.VlanEnabled = types.BoolValue(false)
+	assert.True(t, validateNetworkVlan(model).HasError())
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_network"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+// natOutboundIpAddressesValue converts the outbound NAT addresses of a
+// network to a list of elemType, the NatOutboundIpAddressesValue type of the
+// resource or a data source.
+func natOutboundIpAddressesValue(ctx context.Context, elemType attr.Type, addresses []unifi.NetworkNATOutboundIPAddresses) (types.List, diag.Diagnostics) {
+	return objectListValue(ctx, elemType, addresses, natOutboundIpAddressAttributes)
+}
+
+func natOutboundIpAddressAttributes(ctx context.Context, json unifi.NetworkNATOutboundIPAddresses, attributes map[string]attr.Value) diag.Diagnostics {
+	addressPoolList, diags := types.ListValueFrom(ctx, types.StringType, json.IPAddressPool)
+
+	attributes["ip_address"] = types.StringValue(json.IPAddress)
+	attributes["ip_address_pool"] = addressPoolList
+	attributes["mode"] = types.StringValue(json.Mode)
+	attributes["wan_network_group"] = types.StringValue(json.WANNetworkGroup)
+
+	return diags
+}
+
+// natOutboundIpAddressesJson converts the configured outbound NAT addresses
+// of a network resource to the API type. Each address is matched with the
+// current address of the same WAN network group and IP address, so the
+// settings left unknown in the plan are kept. Null and unknown addresses are
+// left out.
+func natOutboundIpAddressesJson(ctx context.Context, list types.List, current []unifi.NetworkNATOutboundIPAddresses) ([]unifi.NetworkNATOutboundIPAddresses, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+
+	var values []resource_network.NatOutboundIpAddressesValue
+	diags := list.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	matched := make([]bool, len(current))
+	addresses := make([]unifi.NetworkNATOutboundIPAddresses, 0, len(values))
+	for _, value := range values {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		address := unifi.NetworkNATOutboundIPAddresses{
+			IPAddress:       value.IpAddress.ValueString(),
+			WANNetworkGroup: value.WanNetworkGroup.ValueString(),
+		}
+		for i, c := range current {
+			if matched[i] || c.WANNetworkGroup != address.WANNetworkGroup {
+				continue
+			}
+			if isSet(value.IpAddress) && c.IPAddress != address.IPAddress {
+				continue
+			}
+
+			matched[i] = true
+			address = c
+			break
+		}
+
+		if isSet(value.IpAddress) {
+			address.IPAddress = value.IpAddress.ValueString()
+		}
+		if isSet(value.IpAddressPool) {
+			address.IPAddressPool = nil
+			diags.Append(value.IpAddressPool.ElementsAs(ctx, &address.IPAddressPool, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+		if isSet(value.Mode) {
+			address.Mode = value.Mode.ValueString()
+		}
+		if isSet(value.WanNetworkGroup) {
+			address.WANNetworkGroup = value.WanNetworkGroup.ValueString()
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses, diags
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/datasource_networks"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_network"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+func TestNatOutboundIpAddresses_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	addresses := []unifi.NetworkNATOutboundIPAddresses{
+		{Mode: "ip_address", IPAddress: "203.0.113.10", WANNetworkGroup: "WAN"},
+		{Mode: "ip_address_pool", IPAddressPool: []string{"198.51.100.10", "198.51.100.11"}, WANNetworkGroup: "WAN2"},
+		{Mode: "all", WANNetworkGroup: "WAN"},
+	}
+
+	list, diags := natOutboundIpAddressesValue(ctx, resource_network.NatOutboundIpAddressesValue{}.Type(ctx), addresses)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, 3, len(list.Elements()))
+
+	var values []resource_network.NatOutboundIpAddressesValue
+	assert.False(t, list.ElementsAs(ctx, &values, false).HasError())
+	assert.Equal(t, types.StringValue("203.0.113.10"), values[0].IpAddress)
+	assert.Equal(t, types.StringValue("ip_address_pool"), values[1].Mode)
+	assert.Equal(t, types.StringValue("WAN2"), values[1].WanNetworkGroup)
+	assert.True(t, values[2].IpAddressPool.IsNull())
+
+	json, diags := natOutboundIpAddressesJson(ctx, list, nil)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, addresses, json)
+}
+
+func TestNatOutboundIpAddressesJson_Config(t *testing.T) {
+	ctx := context.Background()
+	attrTypes := resource_network.NatOutboundIpAddressesValue{}.AttributeTypes(ctx)
+
+	value := resource_network.NewNatOutboundIpAddressesValueMust(attrTypes, map[string]attr.Value{
+		"ip_address":        types.StringUnknown(),
+		"ip_address_pool":   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("198.51.100.10")}),
+		"mode":              types.StringValue("ip_address_pool"),
+		"wan_network_group": types.StringValue("WAN"),
+	})
+	list := types.ListValueMust(resource_network.NatOutboundIpAddressesValue{}.Type(ctx), []attr.Value{value})
+
+	json, diags := natOutboundIpAddressesJson(ctx, list, nil)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []unifi.NetworkNATOutboundIPAddresses{
+		{IPAddressPool: []string{"198.51.100.10"}, Mode: "ip_address_pool", WANNetworkGroup: "WAN"},
+	}, json)
+
+	json, diags = natOutboundIpAddressesJson(ctx, types.ListNull(resource_network.NatOutboundIpAddressesValue{}.Type(ctx)), nil)
+	assert.False(t, diags.HasError(), diags)
+	assert.Nil(t, json)
+}
+
+func TestNatOutboundIpAddressesJson_MergesCurrent(t *testing.T) {
+	ctx := context.Background()
+	attrTypes := resource_network.NatOutboundIpAddressesValue{}.AttributeTypes(ctx)
+
+	current := []unifi.NetworkNATOutboundIPAddresses{
+		{Mode: "ip_address_pool", IPAddressPool: []string{"198.51.100.10", "198.51.100.11"}, WANNetworkGroup: "WAN"},
+		{Mode: "ip_address", IPAddress: "203.0.113.10", WANNetworkGroup: "WAN2"},
+		{Mode: "ip_address", IPAddress: "203.0.113.20", WANNetworkGroup: "WAN2"},
+	}
+
+	address := func(wanNetworkGroup string, ipAddress, mode attr.Value) attr.Value {
+		return resource_network.NewNatOutboundIpAddressesValueMust(attrTypes, map[string]attr.Value{
+			"ip_address":        ipAddress,
+			"ip_address_pool":   types.ListUnknown(types.StringType),
+			"mode":              mode,
+			"wan_network_group": types.StringValue(wanNetworkGroup),
+		})
+	}
+	list := types.ListValueMust(resource_network.NatOutboundIpAddressesValue{}.Type(ctx), []attr.Value{
+		address("WAN2", types.StringValue("203.0.113.20"), types.StringUnknown()),
+		address("WAN", types.StringUnknown(), types.StringValue("ip_address_pool")),
+		address("WAN3", types.StringValue("192.0.2.10"), types.StringValue("ip_address")),
+	})
+
+	// The address pool isn't configured, so it is kept for the WAN address,
+	// and the WAN2 address is matched by its IP address.
+	json, diags := natOutboundIpAddressesJson(ctx, list, current)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []unifi.NetworkNATOutboundIPAddresses{
+		{Mode: "ip_address", IPAddress: "203.0.113.20", WANNetworkGroup: "WAN2"},
+		{Mode: "ip_address_pool", IPAddressPool: []string{"198.51.100.10", "198.51.100.11"}, WANNetworkGroup: "WAN"},
+		{Mode: "ip_address", IPAddress: "192.0.2.10", WANNetworkGroup: "WAN3"},
+	}, json)
+}
+
+func TestNetworksMapping_NatOutboundIpAddresses(t *testing.T) {
+	ctx := context.Background()
+
+	var model datasource_networks.NetworksModel
+	diags := parseNetworksDataSourceJson(ctx, []unifi.Network{{
+		ID: "1",
+		NATOutboundIPAddresses: []unifi.NetworkNATOutboundIPAddresses{
+			{Mode: "ip_address", IPAddress: "203.0.113.10", WANNetworkGroup: "WAN2"},
+		},
+	}}, &model)
+	assert.False(t, diags.HasError(), diags)
+
+	var networks []datasource_networks.NetworksValue
+	assert.False(t, model.Networks.ElementsAs(ctx, &networks, false).HasError())
+
+	var addresses []datasource_networks.NatOutboundIpAddressesValue
+	assert.False(t, networks[0].NatOutboundIpAddresses.ElementsAs(ctx, &addresses, false).HasError())
+	assert.Equal(t, 1, len(addresses))
+	assert.Equal(t, types.StringValue("203.0.113.10"), addresses[0].IpAddress)
+	assert.Equal(t, types.StringValue("WAN2"), addresses[0].WanNetworkGroup)
+}
+
+func TestNetworkResource_ParseModelKeepsNatOutboundIpAddresses(t *testing.T) {
+	ctx := context.Background()
+	current := unifi.Network{
+		ID: "net-123",
+		NATOutboundIPAddresses: []unifi.NetworkNATOutboundIPAddresses{
+			{Mode: "ip_address", IPAddress: "203.0.113.10", WANNetworkGroup: "WAN"},
+		},
+	}
+
+	model := resource_network.NetworkModel{
+		Id:                     types.StringValue("net-123"),
+		Name:                   types.StringValue("LAN"),
+		NatOutboundIpAddresses: types.ListUnknown(resource_network.NatOutboundIpAddressesValue{}.Type(ctx)),
+	}
+
+	body := current
+	diags := parseNetworkResourceModel(ctx, model, &body)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, current.NATOutboundIPAddresses, body.NATOutboundIPAddresses)
+
+	// An empty list removes the addresses.
+	model.NatOutboundIpAddresses = types.ListValueMust(resource_network.NatOutboundIpAddressesValue{}.Type(ctx), []attr.Value{})
+	body = current
+	diags = parseNetworkResourceModel(ctx, model, &body)
+	assert.False(t, diags.HasError(), diags)
+	assert.Empty(t, body.NATOutboundIPAddresses)
+}
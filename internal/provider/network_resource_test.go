@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_network"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+func TestNetworkResource_ParseModelKeepsUnknownAttributes(t *testing.T) {
+	current := unifi.Network{
+		ID:                "net-123",
+		Name:              "IoT",
+		Purpose:           "corporate",
+		IPSubnet:          "10.0.20.1/24",
+		VLAN:              20,
+		VLANEnabled:       true,
+		DHCPDEnabled:      true,
+		DHCPDStart:        "10.0.20.100",
+		DHCPDStop:         "10.0.20.200",
+		DHCPDLeaseTime:    86400,
+		DHCPDDNS1:         "1.1.1.1",
+		DHCPDDNS2:         "9.9.9.9",
+		DomainName:        "iot.home.arpa",
+		IGMPSnooping:      true,
+		DHCPDBootFilename: "pxelinux.0",
+	}
+
+	// Only the name and DHCP range are configured, the other Optional and
+	// Computed attributes are unknown in the plan.
+	model := resource_network.NetworkModel{
+		Id:              types.StringValue("net-123"),
+		Name:            types.StringValue("Things"),
+		Purpose:         types.StringValue("corporate"),
+		Subnet:          customtypes.NewIPPrefixValue("10.0.20.1/24"),
+		DhcpStart:       types.StringValue("10.0.20.50"),
+		DhcpStop:        types.StringUnknown(),
+		DhcpLeaseTime:   types.Int64Unknown(),
+		DhcpEnabled:     types.BoolUnknown(),
+		DhcpDns:         types.ListUnknown(types.StringType),
+		DomainName:      types.StringUnknown(),
+		IgmpSnooping:    types.BoolUnknown(),
+		VlanId:          types.Int64Unknown(),
+		VlanEnabled:     types.BoolUnknown(),
+		DhcpBootEnabled: types.BoolUnknown(),
+	}
+
+	body := current
+	diags := parseNetworkResourceModel(context.Background(), model, &body)
+	assert.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "Things", body.Name)
+	assert.Equal(t, "10.0.20.50", body.DHCPDStart)
+	assert.Equal(t, "10.0.20.200", body.DHCPDStop)
+	assert.Equal(t, 86400, body.DHCPDLeaseTime)
+	assert.True(t, body.DHCPDEnabled)
+	assert.Equal(t, "1.1.1.1", body.DHCPDDNS1)
+	assert.Equal(t, "9.9.9.9", body.DHCPDDNS2)
+	assert.Equal(t, "iot.home.arpa", body.DomainName)
+	assert.True(t, body.IGMPSnooping)
+	assert.Equal(t, 20, body.VLAN)
+	assert.True(t, body.VLANEnabled)
+	assert.Equal(t, "pxelinux.0", body.DHCPDBootFilename)
+
+	// Configured values still replace the current ones.
+	model.DhcpDns = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.20.1")})
+	body = current
+	diags = parseNetworkResourceModel(context.Background(), model, &body)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "10.0.20.1", body.DHCPDDNS1)
+	assert.Equal(t, "", body.DHCPDDNS2)
+}
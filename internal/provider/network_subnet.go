@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// networkSubnetAddresses derives the network address and the netmask from the
+// subnet of a network, e.g. 192.168.1.0 and 255.255.255.0 for 192.168.1.1/24.
+// Both are empty when the network has no subnet, and the netmask is empty for
+// IPv6 subnets.
+func networkSubnetAddresses(subnet customtypes.IPPrefix) (networkAddress, netmask types.String) {
+	if subnet.IsUnknown() {
+		return types.StringUnknown(), types.StringUnknown()
+	}
+
+	prefix, err := subnet.ValuePrefix()
+	if subnet.IsNull() || err != nil {
+		return types.StringValue(""), types.StringValue("")
+	}
+
+	networkAddress = types.StringValue(prefix.Masked().Addr().String())
+	netmask = types.StringValue("")
+	if prefix.Addr().Is4() {
+		netmask = types.StringValue(net.IP(net.CIDRMask(prefix.Bits(), 32)).String())
+	}
+
+	return networkAddress, netmask
+}
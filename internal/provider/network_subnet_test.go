@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+)
+
+func TestNetworkSubnetAddresses(t *testing.T) {
+	for _, tc := range []struct {
+		subnet                  customtypes.IPPrefix
+		networkAddress, netmask types.String
+	}{
+		{customtypes.NewIPPrefixValue("192.168.1.1/24"), types.StringValue("192.168.1.0"), types.StringValue("255.255.255.0")},
+		{customtypes.NewIPPrefixValue("10.20.30.40/12"), types.StringValue("10.16.0.0"), types.StringValue("255.240.0.0")},
+		{customtypes.NewIPPrefixValue("2001:db8::1/64"), types.StringValue("2001:db8::"), types.StringValue("")},
+		{customtypes.NewIPPrefixValue(""), types.StringValue(""), types.StringValue("")},
+		{customtypes.NewIPPrefixNull(), types.StringValue(""), types.StringValue("")},
+		{customtypes.NewIPPrefixUnknown(), types.StringUnknown(), types.StringUnknown()},
+	} {
+		networkAddress, netmask := networkSubnetAddresses(tc.subnet)
+		assert.Equal(t, tc.networkAddress, networkAddress, tc.subnet.String())
+		assert.Equal(t, tc.netmask, netmask, tc.subnet.String())
+	}
+}
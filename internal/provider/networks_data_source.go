@@ -9,9 +9,9 @@ import (
 
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_networks"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,7 +84,7 @@ func (d *networksDataSource) Read(ctx context.Context, req datasource.ReadReques
 }
 
 func parseNetworksDataSourceJson(ctx context.Context, json []unifi.Network, model *datasource_networks.NetworksModel) diag.Diagnostics {
-	networksList, diags := types.ListValueFrom(ctx, datasource_networks.NetworksValue{}.Type(ctx), json)
+	networksList, diags := objectListValue(ctx, datasource_networks.NetworksValue{}.Type(ctx), json, parseNetworksDataSourceItemJson)
 	if diags.HasError() {
 		return diags
 	}
@@ -92,3 +92,14 @@ func parseNetworksDataSourceJson(ctx context.Context, json []unifi.Network, mode
 
 	return nil
 }
+
+func parseNetworksDataSourceItemJson(ctx context.Context, json unifi.Network, attributes map[string]attr.Value) diag.Diagnostics {
+	diags := mapNetworksDataSourceJson(ctx, json, attributes)
+	if diags.HasError() {
+		return diags
+	}
+
+	attributes["nat_outbound_ip_addresses"], diags = natOutboundIpAddressesValue(ctx, datasource_networks.NatOutboundIpAddressesValue{}.Type(ctx), json.NATOutboundIPAddresses)
+
+	return diags
+}
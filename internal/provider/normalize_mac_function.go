@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &normalizeMacFunction{}
+
+func NewNormalizeMacFunction() function.Function {
+	return &normalizeMacFunction{}
+}
+
+// normalizeMacFunction converts a MAC address to the format used by the
+// controller.
+type normalizeMacFunction struct{}
+
+func (f *normalizeMacFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_mac"
+}
+
+func (f *normalizeMacFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Normalize a MAC address.",
+		MarkdownDescription: "Converts a MAC address of six octets separated by colons or hyphens to lowercase octets separated by colons, the format used by the Unifi Controller, e.g. `00-11-22-AA-BB-CC` to `00:11:22:aa:bb:cc`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "mac",
+				MarkdownDescription: "The MAC address to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *normalizeMacFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var mac string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &mac))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := customtypes.NormalizeMacAddress(mac)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid MAC address: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
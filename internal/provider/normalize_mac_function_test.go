@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeMacFunction(t *testing.T) {
+	ctx := context.Background()
+	run := func(mac string) function.RunResponse {
+		resp := function.RunResponse{
+			Result: function.NewResultData(types.StringUnknown()),
+		}
+		NewNormalizeMacFunction().Run(ctx, function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(mac)}),
+		}, &resp)
+		return resp
+	}
+
+	for _, mac := range []string{"00-11-22-aa-bb-cc", "00-11-22-AA-BB-CC", "00:11:22:AA:BB:CC", "00:11:22:aa:bb:cc"} {
+		resp := run(mac)
+		assert.Nil(t, resp.Error, mac)
+		assert.Equal(t, function.NewResultData(types.StringValue("00:11:22:aa:bb:cc")), resp.Result, mac)
+	}
+
+	// Only colons and hyphens are accepted as separators, as by the MAC
+	// address attributes.
+	for _, mac := range []string{"", "0011.22aa.bbcc", "00.11.22.aa.bb.cc", "00:11:22:aa:bb", "00:11:22:aa:bb:zz"} {
+		resp := run(mac)
+		if assert.NotNil(t, resp.Error, mac) {
+			assert.Equal(t, int64(0), *resp.Error.FunctionArgument, mac)
+			assert.Contains(t, resp.Error.Error(), "Invalid MAC address", mac)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseImportIdFunction{}
+
+var parseImportIdAttributeTypes = map[string]attr.Type{
+	"site": types.StringType,
+	"id":   types.StringType,
+}
+
+func NewParseImportIdFunction() function.Function {
+	return &parseImportIdFunction{}
+}
+
+// parseImportIdFunction splits the identifier used to import resources into
+// the site and the ID of the object.
+type parseImportIdFunction struct{}
+
+func (f *parseImportIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_import_id"
+}
+
+func (f *parseImportIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Split an import identifier into its site and ID.",
+		MarkdownDescription: "Splits an import identifier of the format `site/id`, as accepted by `terraform import` and `import` blocks, into an object with the `site` and `id` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "import_id",
+				MarkdownDescription: "The import identifier, e.g. `default/5f1c2b3a4d5e6f7a8b9c0d1e`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseImportIdAttributeTypes,
+		},
+	}
+}
+
+func (f *parseImportIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var importID string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &importID))
+	if resp.Error != nil {
+		return
+	}
+
+	site, id, ok := parseImportID(importID)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", importID))
+		return
+	}
+
+	result, diags := types.ObjectValue(parseImportIdAttributeTypes, map[string]attr.Value{
+		"site": types.StringValue(site),
+		"id":   types.StringValue(id),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// parseImportID splits an import identifier of the format site/id. ok is
+// false when the identifier doesn't have exactly two non-empty parts.
+func parseImportID(importID string) (site, id string, ok bool) {
+	idParts := strings.Split(importID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", false
+	}
+
+	return idParts[0], idParts[1], true
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImportIdFunction(t *testing.T) {
+	ctx := context.Background()
+	run := func(importID string) function.RunResponse {
+		resp := function.RunResponse{
+			Result: function.NewResultData(types.ObjectUnknown(parseImportIdAttributeTypes)),
+		}
+		NewParseImportIdFunction().Run(ctx, function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(importID)}),
+		}, &resp)
+		return resp
+	}
+
+	resp := run("default/5f1c2b3a4d5e6f7a8b9c0d1e")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, function.NewResultData(types.ObjectValueMust(parseImportIdAttributeTypes, map[string]attr.Value{
+		"site": types.StringValue("default"),
+		"id":   types.StringValue("5f1c2b3a4d5e6f7a8b9c0d1e"),
+	})), resp.Result)
+
+	for _, importID := range []string{"", "default", "default/", "/id", "a/b/c"} {
+		resp := run(importID)
+		if assert.NotNil(t, resp.Error, importID) {
+			assert.Contains(t, resp.Error.Error(), "Expected import identifier with format: site/id")
+		}
+	}
+}
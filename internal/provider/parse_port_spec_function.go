@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parsePortSpecFunction{}
+
+func NewParsePortSpecFunction() function.Function {
+	return &parsePortSpecFunction{}
+}
+
+// parsePortSpecFunction validates a port specification, as used by firewall
+// rules, port forwards and port groups, and expands it to its ports.
+type parsePortSpecFunction struct{}
+
+func (f *parsePortSpecFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_port_spec"
+}
+
+func (f *parsePortSpecFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Expand a port specification to its ports.",
+		MarkdownDescription: "Validates a port specification of ports and port ranges separated by commas, e.g. `80,443,8000-8100`, and returns the list of ports it contains, in the order they are listed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "port_spec",
+				MarkdownDescription: "The port specification, e.g. `80,443,8000-8100`.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.Int64Type,
+		},
+	}
+}
+
+func (f *parsePortSpecFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var portSpec string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &portSpec))
+	if resp.Error != nil {
+		return
+	}
+
+	ranges, err := validators.ParsePortSpec(portSpec)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid port specification: "+err.Error())
+		return
+	}
+
+	var ports []int64
+	for _, portRange := range ranges {
+		for port := portRange.First; port <= portRange.Last; port++ {
+			ports = append(ports, int64(port))
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, ports))
+}
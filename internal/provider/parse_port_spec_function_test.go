@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func runParsePortSpec(portSpec string) function.RunResponse {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(portSpec)}),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.ListUnknown(types.Int64Type)),
+	}
+
+	NewParsePortSpecFunction().Run(context.Background(), req, &resp)
+
+	return resp
+}
+
+func TestParsePortSpecFunction(t *testing.T) {
+	resp := runParsePortSpec("443,80,8000-8002")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, function.NewResultData(types.ListValueMust(types.Int64Type, []attr.Value{
+		types.Int64Value(443),
+		types.Int64Value(80),
+		types.Int64Value(8000),
+		types.Int64Value(8001),
+		types.Int64Value(8002),
+	})), resp.Result)
+
+	for _, portSpec := range []string{"", "0", "65536", "80,", "90-80", "http"} {
+		resp := runParsePortSpec(portSpec)
+		assert.NotNil(t, resp.Error, portSpec)
+	}
+}
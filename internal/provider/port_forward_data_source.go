@@ -10,7 +10,6 @@ import (
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_port_forward"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -73,22 +72,8 @@ func (d *portForwardDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	parsePortForwardDataSourceJson(*portForward, &data)
+	mapPortForwardDataSourceJson(*portForward, &data)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-func parsePortForwardDataSourceJson(json unifi.PortForward, model *datasource_port_forward.PortForwardModel) {
-	model.Id = types.StringValue(json.ID)
-	model.SiteId = types.StringValue(json.SiteID)
-	model.DstPort = types.StringValue(json.DstPort)
-	model.Enabled = types.BoolValue(json.Enabled)
-	model.FwdIp = types.StringValue(json.Fwd)
-	model.FwdPort = types.StringValue(json.FwdPort)
-	model.Log = types.BoolValue(json.Log)
-	model.Name = types.StringValue(json.Name)
-	model.PortForwardInterface = types.StringValue(json.PfwdInterface)
-	model.Protocol = types.StringValue(json.Proto)
-	model.SrcIp = types.StringValue(json.Src)
-}
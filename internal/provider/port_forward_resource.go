@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/zoullx/terraform-provider-unifi/internal/resource_port_forward"
@@ -61,9 +60,8 @@ func (r *portForwardResource) Configure(ctx context.Context, req resource.Config
 }
 
 func (r *portForwardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
 			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
@@ -71,11 +69,16 @@ func (r *portForwardResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (r *portForwardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create Port Forward")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_port_forward.PortForwardModel
 
 	// Read Terraform plan data into the model
@@ -85,7 +88,7 @@ func (r *portForwardResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	var body unifi.PortForward
-	parsePortForwardResourceModel(data, &body)
+	mapPortForwardResourceModel(data, &body)
 	portForward, err := r.client.CreatePortForward(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -95,7 +98,7 @@ func (r *portForwardResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	parsePortForwardResourceJson(*portForward, &data)
+	mapPortForwardResourceJson(*portForward, &data)
 
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -122,13 +125,18 @@ func (r *portForwardResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	parsePortForwardResourceJson(*portForward, &data)
+	mapPortForwardResourceJson(*portForward, &data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *portForwardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update Port Forward")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_port_forward.PortForwardModel
 
 	// Read Terraform plan data into the model
@@ -137,8 +145,16 @@ func (r *portForwardResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	var body unifi.PortForward
-	parsePortForwardResourceModel(data, &body)
+	current, diags := readForUpdate(ctx, r.client, nil, "Port Forward", func() (*unifi.PortForward, error) {
+		return r.client.GetPortForward(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	mapPortForwardResourceModel(data, &body)
 	portForward, err := r.client.UpdatePortForward(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -148,7 +164,7 @@ func (r *portForwardResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	parsePortForwardResourceJson(*portForward, &data)
+	mapPortForwardResourceJson(*portForward, &data)
 
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -157,6 +173,11 @@ func (r *portForwardResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *portForwardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete Port Forward")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_port_forward.PortForwardModel
 
 	// Read Terraform prior state data into the model
@@ -175,31 +196,3 @@ func (r *portForwardResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 }
-
-func parsePortForwardResourceJson(json unifi.PortForward, model *resource_port_forward.PortForwardModel) {
-	model.Id = types.StringValue(json.ID)
-	model.SiteId = types.StringValue(json.SiteID)
-	model.DstPort = types.StringValue(json.DstPort)
-	model.Enabled = types.BoolValue(json.Enabled)
-	model.FwdIp = types.StringValue(json.Fwd)
-	model.FwdPort = types.StringValue(json.FwdPort)
-	model.Log = types.BoolValue(json.Log)
-	model.Name = types.StringValue(json.Name)
-	model.PortForwardInterface = types.StringValue(json.PfwdInterface)
-	model.Protocol = types.StringValue(json.Proto)
-	model.SrcIp = types.StringValue(json.Src)
-}
-
-func parsePortForwardResourceModel(model resource_port_forward.PortForwardModel, json *unifi.PortForward) {
-	json.ID = model.Id.ValueString()
-	json.SiteID = model.SiteId.ValueString()
-	json.DstPort = model.DstPort.ValueString()
-	json.Enabled = model.Enabled.ValueBool()
-	json.Fwd = model.FwdIp.ValueString()
-	json.FwdPort = model.FwdPort.ValueString()
-	json.Log = model.Log.ValueBool()
-	json.Name = model.Name.ValueString()
-	json.PfwdInterface = model.PortForwardInterface.ValueString()
-	json.Proto = model.Protocol.ValueString()
-	json.Src = model.SrcIp.ValueString()
-}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,7 +83,7 @@ func (d *portForwardsDataSource) Read(ctx context.Context, req datasource.ReadRe
 }
 
 func parsePortForwardsDataSourceJson(ctx context.Context, json []unifi.PortForward, model *datasource_port_forwards.PortForwardsModel) diag.Diagnostics {
-	portForwardList, diags := types.ListValueFrom(ctx, datasource_port_forwards.PortForwardsValue{}.Type(ctx), json)
+	portForwardList, diags := objectListValue(ctx, datasource_port_forwards.PortForwardsValue{}.Type(ctx), json, mapPortForwardsDataSourceJson)
 	if diags.HasError() {
 		return diags
 	}
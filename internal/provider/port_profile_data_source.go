@@ -10,7 +10,6 @@ import (
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_port_profile"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -73,13 +72,8 @@ func (d *portProfileDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	parsePortProfileDataSourceJson(*portProfile, &data)
+	mapPortProfileDataSourceJson(*portProfile, &data)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-func parsePortProfileDataSourceJson(json unifi.PortProfile, model *datasource_port_profile.PortProfileModel) {
-	model.Id = types.StringValue(json.ID)
-	model.Name = types.StringValue(json.Name)
-}
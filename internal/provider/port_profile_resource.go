@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/zoullx/terraform-provider-unifi/internal/resource_port_profile"
@@ -61,9 +60,8 @@ func (r *portProfileResource) Configure(ctx context.Context, req resource.Config
 }
 
 func (r *portProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
 			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
@@ -71,11 +69,16 @@ func (r *portProfileResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (r *portProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create Port Profile")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_port_profile.PortProfileModel
 
 	// Read Terraform plan data into the model
@@ -85,7 +88,7 @@ func (r *portProfileResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	var body unifi.PortProfile
-	parsePortProfileResourceModel(data, &body)
+	mapPortProfileResourceModel(data, &body)
 	portProfile, err := r.client.CreatePortProfile(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -95,7 +98,7 @@ func (r *portProfileResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	parsePortProfileResourceJson(*portProfile, &data)
+	mapPortProfileResourceJson(*portProfile, &data)
 
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -122,13 +125,18 @@ func (r *portProfileResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	parsePortProfileResourceJson(*portProfile, &data)
+	mapPortProfileResourceJson(*portProfile, &data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *portProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update Port Profile")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_port_profile.PortProfileModel
 
 	// Read Terraform plan data into the model
@@ -137,8 +145,16 @@ func (r *portProfileResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	var body unifi.PortProfile
-	parsePortProfileResourceModel(data, &body)
+	current, diags := readForUpdate(ctx, r.client, nil, "Port Profile", func() (*unifi.PortProfile, error) {
+		return r.client.GetPortProfile(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	mapPortProfileResourceModel(data, &body)
 	portProfile, err := r.client.UpdatePortProfile(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -148,7 +164,7 @@ func (r *portProfileResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	parsePortProfileResourceJson(*portProfile, &data)
+	mapPortProfileResourceJson(*portProfile, &data)
 
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -157,6 +173,11 @@ func (r *portProfileResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *portProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete Port Profile")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_port_profile.PortProfileModel
 
 	// Read Terraform prior state data into the model
@@ -175,13 +196,3 @@ func (r *portProfileResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 }
-
-func parsePortProfileResourceJson(json unifi.PortProfile, model *resource_port_profile.PortProfileModel) {
-	model.Id = types.StringValue(json.ID)
-	model.Name = types.StringValue(json.Name)
-}
-
-func parsePortProfileResourceModel(model resource_port_profile.PortProfileModel, json *unifi.PortProfile) {
-	json.ID = model.Id.ValueString()
-	json.Name = model.Name.ValueString()
-}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,7 +83,7 @@ func (d *portProfilesDataSource) Read(ctx context.Context, req datasource.ReadRe
 }
 
 func parsePortProfilesDataSourceJson(ctx context.Context, json []unifi.PortProfile, model *datasource_port_profiles.PortProfilesModel) diag.Diagnostics {
-	portProfileList, diags := types.ListValueFrom(ctx, datasource_port_profiles.PortProfilesValue{}.Type(ctx), json)
+	portProfileList, diags := objectListValue(ctx, datasource_port_profiles.PortProfilesValue{}.Type(ctx), json, mapPortProfilesDataSourceJson)
 	if diags.HasError() {
 		return diags
 	}
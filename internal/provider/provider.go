@@ -5,20 +5,33 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/zoullx/terraform-provider-unifi/internal/provider_unifi"
 
 	"github.com/zoullx/unifi-go/unifi"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-var _ provider.Provider = &UnifiProvider{}
+var (
+	_ provider.Provider                       = &UnifiProvider{}
+	_ provider.ProviderWithEphemeralResources = &UnifiProvider{}
+	_ provider.ProviderWithFunctions          = &UnifiProvider{}
+)
 
 type UnifiProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -77,6 +90,23 @@ func (p *UnifiProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	host := os.Getenv("UNIFI_HOST")
 	apiKey := os.Getenv("UNIFI_API_KEY")
 	insecure := false
+	httpProxy := os.Getenv("UNIFI_HTTP_PROXY")
+	maxRetries := envInt64(&resp.Diagnostics, "max_retries", "UNIFI_MAX_RETRIES", defaultMaxRetries, 0)
+	maxRetryWait := envInt64(&resp.Diagnostics, "max_retry_wait", "UNIFI_MAX_RETRY_WAIT", int64(defaultMaxRetryWait/time.Second), 1)
+	connectTimeout := envInt64(&resp.Diagnostics, "connect_timeout", "UNIFI_CONNECT_TIMEOUT", int64(defaultConnectTimeout/time.Second), 1)
+	requestTimeout := envInt64(&resp.Diagnostics, "request_timeout", "UNIFI_REQUEST_TIMEOUT", 0, 1)
+	headers := map[string]string{}
+	readOnly := envBool(&resp.Diagnostics, "read_only", "UNIFI_READ_ONLY")
+	forceOverwrite := envBool(&resp.Diagnostics, "force_overwrite", "UNIFI_FORCE_OVERWRITE")
+	lockoutGuard := envBool(&resp.Diagnostics, "lockout_guard", "UNIFI_LOCKOUT_GUARD")
+	allowLockout := envBool(&resp.Diagnostics, "", "UNIFI_ALLOW_LOCKOUT")
+	var managementAddresses []netip.Addr
+	tlsOpts := tlsOptions{
+		caCertificate:     os.Getenv("UNIFI_CA_CERTIFICATE"),
+		serverName:        os.Getenv("UNIFI_TLS_SERVER_NAME"),
+		clientCertificate: os.Getenv("UNIFI_CLIENT_CERTIFICATE"),
+		clientKey:         os.Getenv("UNIFI_CLIENT_KEY"),
+	}
 
 	if !data.Host.IsNull() {
 		host = data.Host.ValueString()
@@ -90,6 +120,93 @@ func (p *UnifiProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		insecure = data.AllowInsecure.ValueBool()
 	}
 
+	if !data.MaxRetries.IsNull() {
+		maxRetries = data.MaxRetries.ValueInt64()
+	}
+
+	if !data.MaxRetryWait.IsNull() {
+		maxRetryWait = data.MaxRetryWait.ValueInt64()
+	}
+
+	if !data.CaCertificate.IsNull() {
+		tlsOpts.caCertificate = data.CaCertificate.ValueString()
+	}
+
+	if !data.TlsServerName.IsNull() {
+		tlsOpts.serverName = data.TlsServerName.ValueString()
+	}
+
+	if !data.ClientCertificate.IsNull() {
+		tlsOpts.clientCertificate = data.ClientCertificate.ValueString()
+	}
+
+	if !data.ClientKey.IsNull() {
+		tlsOpts.clientKey = data.ClientKey.ValueString()
+	}
+
+	tlsOpts.insecure = insecure
+
+	if !data.HttpProxy.IsNull() {
+		httpProxy = data.HttpProxy.ValueString()
+	}
+
+	if !data.ConnectTimeout.IsNull() {
+		connectTimeout = data.ConnectTimeout.ValueInt64()
+	}
+
+	if !data.RequestTimeout.IsNull() {
+		requestTimeout = data.RequestTimeout.ValueInt64()
+	}
+
+	if !data.Headers.IsUnknown() && !data.Headers.IsNull() {
+		resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &headers, false)...)
+	}
+
+	if !data.ReadOnly.IsNull() {
+		readOnly = data.ReadOnly.ValueBool()
+	}
+
+	if !data.ForceOverwrite.IsNull() {
+		forceOverwrite = data.ForceOverwrite.ValueBool()
+	}
+
+	if !data.LockoutGuard.IsNull() {
+		lockoutGuard = data.LockoutGuard.ValueBool()
+	}
+
+	if !data.ManagementAddresses.IsUnknown() && !data.ManagementAddresses.IsNull() {
+		var addresses []string
+		resp.Diagnostics.Append(data.ManagementAddresses.ElementsAs(ctx, &addresses, false)...)
+
+		for i, address := range addresses {
+			addr, err := netip.ParseAddr(address)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("management_addresses").AtListIndex(i),
+					"Invalid Management Address",
+					fmt.Sprintf("The provider cannot protect the management address %q as it is not a valid IP address.", address),
+				)
+				continue
+			}
+			managementAddresses = append(managementAddresses, addr.Unmap())
+		}
+	}
+
+	var proxyURL *url.URL
+	if httpProxy != "" {
+		var err error
+		proxyURL, err = url.Parse(httpProxy)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("http_proxy"),
+				"Invalid Unifi HTTP Proxy",
+				"The provider cannot create the Unifi API client as the HTTP proxy is not a valid URL. "+
+					"Set the http_proxy value in the configuration or use the UNIFI_HTTP_PROXY environment variable.\n\n"+
+					"Error: "+err.Error(),
+			)
+		}
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -120,16 +237,51 @@ func (p *UnifiProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	ctx = tflog.SetField(ctx, "unifi_host", host)
 	ctx = tflog.SetField(ctx, "unifi_api_key", apiKey)
 	ctx = tflog.SetField(ctx, "unifi_insecure", insecure)
+	ctx = tflog.SetField(ctx, "unifi_max_retries", maxRetries)
+	ctx = tflog.SetField(ctx, "unifi_max_retry_wait", maxRetryWait)
+	ctx = tflog.SetField(ctx, "unifi_tls_server_name", tlsOpts.serverName)
+	ctx = tflog.SetField(ctx, "unifi_http_proxy", httpProxy)
+	ctx = tflog.SetField(ctx, "unifi_connect_timeout", connectTimeout)
+	ctx = tflog.SetField(ctx, "unifi_request_timeout", requestTimeout)
+	ctx = tflog.SetField(ctx, "unifi_read_only", readOnly)
+	ctx = tflog.SetField(ctx, "unifi_force_overwrite", forceOverwrite)
+	ctx = tflog.SetField(ctx, "unifi_lockout_guard", lockoutGuard && !allowLockout)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "unifi_api_key")
 
 	tflog.Debug(ctx, "Creating Unifi client")
 
+	tlsConfig, err := buildTLSConfig(tlsOpts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Unifi TLS Configuration",
+			"The provider cannot create the Unifi API client as the TLS configuration is invalid. "+
+				"Check the ca_certificate, client_certificate and client_key values in the configuration or the matching environment variables.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Requests are sent through a transport that retries transient
+	// failures, e.g. while a gateway reboots for a firmware upgrade.
+	transport := newHTTPTransport(transportOptions{
+		tlsConfig:      tlsConfig,
+		proxyURL:       proxyURL,
+		connectTimeout: time.Duration(connectTimeout) * time.Second,
+		requestTimeout: time.Duration(requestTimeout) * time.Second,
+		headers:        headers,
+	})
+
+	retryTransport := newRetryTransport(transport, int(maxRetries), time.Duration(maxRetryWait)*time.Second)
+
 	// Create a new unifi client using the configuration values
 	client, err := unifi.NewClient(&unifi.ClientConfig{
 		URL:            host,
 		APIKey:         apiKey,
 		VerifySSL:      !insecure,
 		ValidationMode: unifi.DisableValidation,
+		HttpRoundTripperProvider: func() http.RoundTripper {
+			return retryTransport
+		},
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -141,10 +293,26 @@ func (p *UnifiProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	// Make the Unifi client available during DataSource and Resource
-	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	// The lockout guard protects the controller, the address Terraform uses
+	// to reach it, and any configured management addresses.
+	var lockoutAddresses []netip.Addr
+	if lockoutGuard && !allowLockout {
+		lockoutAddresses = append(detectManagementAddresses(ctx, host), managementAddresses...)
+		tflog.Debug(ctx, "Lockout guard enabled", map[string]any{"addresses": fmt.Sprint(lockoutAddresses)})
+	}
+
+	// Make the Unifi client available during DataSource, EphemeralResource
+	// and Resource type Configure methods.
+	providerClient := &unifiClient{
+		Client:           client,
+		api:              newAPIClient(host, apiKey, retryTransport),
+		readOnly:         readOnly,
+		forceOverwrite:   forceOverwrite,
+		lockoutAddresses: lockoutAddresses,
+	}
+	resp.DataSourceData = providerClient
+	resp.EphemeralResourceData = providerClient
+	resp.ResourceData = providerClient
 
 	tflog.Info(ctx, "Configured Unifi client", map[string]any{"success": true})
 }
@@ -154,6 +322,7 @@ func (p *UnifiProvider) Resources(ctx context.Context) []func() resource.Resourc
 		NewAccountResource,
 		NewApGroupResource,
 		NewDeviceResource,
+		NewDnsRecordResource,
 		NewDynamicDnsResource,
 		NewFirewallGroupResource,
 		NewFirewallRuleResource,
@@ -166,8 +335,16 @@ func (p *UnifiProvider) Resources(ctx context.Context) []func() resource.Resourc
 		NewSettingUsgResource,
 		NewSiteResource,
 		NewStaticRouteResource,
+		NewTrafficRouteResource,
+		NewTrafficRuleResource,
 		NewUserResource,
 		NewUserGroupResource,
+		NewVpnOpenvpnClientResource,
+		NewVpnOpenvpnServerResource,
+		NewVpnSiteToSiteResource,
+		NewVpnWireguardPeerResource,
+		NewVpnWireguardServerResource,
+		NewWanResource,
 		NewWlanResource,
 	}
 }
@@ -178,8 +355,11 @@ func (p *UnifiProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		NewAccountsDataSource,
 		NewApGroupDataSource,
 		NewApGroupsDataSource,
+		NewControllerDataSource,
 		NewDeviceDataSource,
 		NewDevicesDataSource,
+		NewDnsRecordsDataSource,
+		NewDpiAppsDataSource,
 		NewDynamicDnsDataSource,
 		NewDynamicDnsesDataSource,
 		NewFirewallGroupDataSource,
@@ -210,6 +390,71 @@ func (p *UnifiProvider) DataSources(ctx context.Context) []func() datasource.Dat
 	}
 }
 
+func (p *UnifiProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewVpnOpenvpnServerClientConfigurationEphemeralResource,
+	}
+}
+
+func (p *UnifiProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewDhcpRangeFunction,
+		NewNormalizeMacFunction,
+		NewParseImportIdFunction,
+		NewParsePortSpecFunction,
+		NewWifiQrPayloadFunction,
+	}
+}
+
+// envInt64 returns the value of the environment variable key as an integer,
+// or defaultValue when it is not set. An error diagnostic is added for the
+// attribute when the value is not an integer of at least minimum.
+func envInt64(diags *diag.Diagnostics, attribute, key string, defaultValue, minimum int64) int64 {
+	v := os.Getenv(key)
+	if v == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || parsed < minimum {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Environment Variable Value",
+			fmt.Sprintf("The %s environment variable must be an integer of at least %d. Got: %q", key, minimum, v),
+		)
+		return defaultValue
+	}
+
+	return parsed
+}
+
+// envBool returns the value of the environment variable key as a boolean, or
+// false when it is not set. An error diagnostic is added for the attribute
+// set by the variable, if any, when the value is not a boolean.
+func envBool(diags *diag.Diagnostics, attribute, key string) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return false
+	}
+
+	parsed, err := strconv.ParseBool(v)
+	if err != nil {
+		summary := "Invalid Environment Variable Value"
+		detail := fmt.Sprintf("The %s environment variable must be a boolean. Got: %q", key, v)
+
+		// Variables without a matching attribute, e.g. UNIFI_ALLOW_LOCKOUT,
+		// aren't reported against one.
+		if attribute == "" {
+			diags.AddError(summary, detail)
+		} else {
+			diags.AddAttributeError(path.Root(attribute), summary, detail)
+		}
+		return false
+	}
+
+	return parsed
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &UnifiProvider{
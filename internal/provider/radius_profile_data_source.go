@@ -10,7 +10,6 @@ import (
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_radius_profile"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -73,13 +72,8 @@ func (d *radiusProfileDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	parseRadiusProfileDataSourceJson(*radiusProfile, &data)
+	mapRadiusProfileDataSourceJson(*radiusProfile, &data)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-func parseRadiusProfileDataSourceJson(json unifi.RADIUSProfile, model *datasource_radius_profile.RadiusProfileModel) {
-	model.Id = types.StringValue(json.ID)
-	model.Name = types.StringValue(json.Name)
-}
@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/zoullx/terraform-provider-unifi/internal/resource_radius_profile"
@@ -61,9 +60,8 @@ func (r *radiusProfileResource) Configure(ctx context.Context, req resource.Conf
 }
 
 func (r *radiusProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
 			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
@@ -71,11 +69,16 @@ func (r *radiusProfileResource) ImportState(ctx context.Context, req resource.Im
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (r *radiusProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create RADIUS Profile")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_radius_profile.RadiusProfileModel
 
 	// Read Terraform plan data into the model
@@ -85,7 +88,7 @@ func (r *radiusProfileResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	var body unifi.RADIUSProfile
-	parseRadiusProfileResourceModel(data, &body)
+	mapRadiusProfileResourceModel(data, &body)
 	radiusProfile, err := r.client.CreateRADIUSProfile(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -95,7 +98,7 @@ func (r *radiusProfileResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	parseRadiusProfileResourceJson(*radiusProfile, &data)
+	mapRadiusProfileResourceJson(*radiusProfile, &data)
 
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -122,13 +125,18 @@ func (r *radiusProfileResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	parseRadiusProfileResourceJson(*radiusProfile, &data)
+	mapRadiusProfileResourceJson(*radiusProfile, &data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *radiusProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update RADIUS Profile")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_radius_profile.RadiusProfileModel
 
 	// Read Terraform plan data into the model
@@ -137,8 +145,16 @@ func (r *radiusProfileResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	var body unifi.RADIUSProfile
-	parseRadiusProfileResourceModel(data, &body)
+	current, diags := readForUpdate(ctx, r.client, nil, "RADIUS Profile", func() (*unifi.RADIUSProfile, error) {
+		return r.client.GetRADIUSProfile(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	mapRadiusProfileResourceModel(data, &body)
 	radiusProfile, err := r.client.UpdateRADIUSProfile(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -148,7 +164,7 @@ func (r *radiusProfileResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	parseRadiusProfileResourceJson(*radiusProfile, &data)
+	mapRadiusProfileResourceJson(*radiusProfile, &data)
 
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -157,6 +173,11 @@ func (r *radiusProfileResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *radiusProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete RADIUS Profile")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_radius_profile.RadiusProfileModel
 
 	// Read Terraform prior state data into the model
@@ -175,13 +196,3 @@ func (r *radiusProfileResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 }
-
-func parseRadiusProfileResourceJson(json unifi.RADIUSProfile, model *resource_radius_profile.RadiusProfileModel) {
-	model.Id = types.StringValue(json.ID)
-	model.Name = types.StringValue(json.Name)
-}
-
-func parseRadiusProfileResourceModel(model resource_radius_profile.RadiusProfileModel, json *unifi.RADIUSProfile) {
-	json.ID = model.Id.ValueString()
-	json.Name = model.Name.ValueString()
-}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,7 +83,7 @@ func (d *radiusProfilesDataSource) Read(ctx context.Context, req datasource.Read
 }
 
 func parseRadiusProfilesDataSourceJson(ctx context.Context, json []unifi.RADIUSProfile, model *datasource_radius_profiles.RadiusProfilesModel) diag.Diagnostics {
-	radiusProfileList, diags := types.ListValueFrom(ctx, datasource_radius_profiles.RadiusProfilesValue{}.Type(ctx), json)
+	radiusProfileList, diags := objectListValue(ctx, datasource_radius_profiles.RadiusProfilesValue{}.Type(ctx), json, mapRadiusProfilesDataSourceJson)
 	if diags.HasError() {
 		return diags
 	}
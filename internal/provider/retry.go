@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultMaxRetryWait = 30 * time.Second
+
+	retryWaitMin = 1 * time.Second
+
+	// retryBodySniffLimit caps how much of an error response body is read
+	// when looking for busy responses from the controller.
+	retryBodySniffLimit = 64 * 1024
+)
+
+var _ http.RoundTripper = &retryTransport{}
+
+// retryTransport is an http.RoundTripper that retries requests to the Unifi
+// Controller which failed with a transient error, using exponential backoff
+// with jitter between attempts.
+//
+// Idempotent requests (GET, HEAD, OPTIONS, PUT and DELETE) are retried on
+// connection errors and 5xx responses. Non-idempotent requests are only
+// retried when the controller is known to have rejected them without
+// processing, e.g. a 429, a 503 or an "api.err.Busy" response, or when the
+// connection could not be established at all.
+type retryTransport struct {
+	next         http.RoundTripper
+	maxRetries   int
+	maxRetryWait time.Duration
+}
+
+func newRetryTransport(next http.RoundTripper, maxRetries int, maxRetryWait time.Duration) *retryTransport {
+	return &retryTransport{
+		next:         next,
+		maxRetries:   maxRetries,
+		maxRetryWait: maxRetryWait,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	// Every attempt sends a clone of the request, so the caller's request is
+	// left untouched. Without GetBody the body is buffered so it can be
+	// replayed on every attempt.
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			var err error
+			body, err = io.ReadAll(req.Body)
+			req.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			req.Body.Close()
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := cloneRequest(ctx, req, body)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.maxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+
+		tflog.Warn(ctx, "Retrying Unifi API request after transient error", map[string]any{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+			"error":   retryReason(resp, err),
+		})
+
+		if resp != nil {
+			// Drain the body so the underlying connection can be reused.
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, retryBodySniffLimit))
+			resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// cloneRequest returns a copy of req for a single attempt. Its body is taken
+// from req.GetBody, or from body when the request can't provide its own.
+func cloneRequest(ctx context.Context, req *http.Request, body []byte) (*http.Request, error) {
+	clone := req.Clone(ctx)
+
+	switch {
+	case req.Body == nil || req.Body == http.NoBody:
+	case req.GetBody != nil:
+		b, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = b
+	default:
+		clone.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	return clone, nil
+}
+
+// backoff returns how long to wait before the next attempt. It honours a
+// Retry-After header when the controller sends one, otherwise it doubles the
+// wait for every attempt and applies jitter. The result never exceeds
+// maxRetryWait.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if s, ok := resp.Header["Retry-After"]; ok {
+			if seconds, err := strconv.Atoi(s[0]); err == nil && seconds >= 0 {
+				return min(time.Duration(seconds)*time.Second, t.maxRetryWait)
+			}
+		}
+	}
+
+	wait := min(retryWaitMin<<attempt, t.maxRetryWait)
+	if wait <= 0 {
+		// The shift overflowed, fall back to the maximum wait.
+		wait = t.maxRetryWait
+	}
+
+	// Full jitter over the upper half of the interval so that concurrent
+	// requests don't hammer a recovering controller in lockstep.
+	half := int64(wait / 2)
+	if half <= 0 {
+		return wait
+	}
+	return time.Duration(half + rand.Int64N(half+1))
+}
+
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+
+		if isConnectError(err) {
+			// The request never reached the controller.
+			return true
+		}
+
+		return isIdempotent(req.Method) && isTransientNetError(err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode == http.StatusServiceUnavailable:
+		return true
+	case isBusyResponse(resp):
+		return true
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return isIdempotent(req.Method)
+	}
+
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isConnectError reports whether err happened while establishing the
+// connection, i.e. before any part of the request was sent.
+func isConnectError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
+
+func isTransientNetError(err error) bool {
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isBusyResponse reports whether the controller rejected the request because
+// it is busy, e.g. while provisioning a device. The response body is restored
+// so callers can still read it.
+func isBusyResponse(resp *http.Response) bool {
+	if resp.StatusCode < http.StatusBadRequest || resp.Body == nil {
+		return false
+	}
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, retryBodySniffLimit))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(b), resp.Body), resp.Body}
+	if err != nil {
+		return false
+	}
+
+	return strings.Contains(string(b), "api.err.Busy")
+}
+
+func retryReason(resp *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return resp.Status
+}
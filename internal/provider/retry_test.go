@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRetryTestServer(t *testing.T, handler func(attempt int32, w http.ResponseWriter, r *http.Request)) (*httptest.Server, *atomic.Int32) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler(attempts.Add(1), w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server, &attempts
+}
+
+func newRetryTestClient(maxRetries int) *http.Client {
+	return &http.Client{
+		Transport: newRetryTransport(http.DefaultTransport, maxRetries, 10*time.Millisecond),
+	}
+}
+
+func TestRetryTransport_RetriesIdempotentServerErrors(t *testing.T) {
+	server, attempts := newRetryTestServer(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"name":"test"}`, string(body))
+
+		if attempt < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"name":"test"}`))
+	resp, err := newRetryTestClient(5).Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestRetryTransport_LeavesRequestUntouched(t *testing.T) {
+	server, attempts := newRetryTestServer(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"name":"test"}`, string(body))
+
+		if attempt%3 != 0 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	transport := newRetryTransport(http.DefaultTransport, 5, 10*time.Millisecond)
+
+	// http.NewRequest sets GetBody for a strings.Reader, wrapping the reader
+	// leaves it nil so the body has to be buffered.
+	for name, body := range map[string]io.Reader{
+		"get body": strings.NewReader(`{"name":"test"}`),
+		"buffered": io.NopCloser(strings.NewReader(`{"name":"test"}`)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPut, server.URL, body)
+			reqBody := req.Body
+
+			resp, err := transport.RoundTrip(req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, reqBody, req.Body)
+			assert.True(t, resp.Request != req, "the request was sent instead of a clone")
+		})
+	}
+	assert.Equal(t, int32(6), attempts.Load())
+}
+
+func TestRetryTransport_DoesNotRetryNonIdempotentServerErrors(t *testing.T) {
+	server, attempts := newRetryTestServer(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{}`))
+	resp, err := newRetryTestClient(5).Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestRetryTransport_RetriesBusyResponses(t *testing.T) {
+	server, attempts := newRetryTestServer(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		if attempt == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"meta":{"rc":"error","msg":"api.err.Busy"},"data":[]}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{}`))
+	resp, err := newRetryTestClient(5).Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), attempts.Load())
+}
+
+func TestRetryTransport_PreservesNonBusyErrorBody(t *testing.T) {
+	server, attempts := newRetryTestServer(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"meta":{"rc":"error","msg":"api.err.InvalidDHCPRange"},"data":[]}`))
+	})
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{}`))
+	resp, err := newRetryTestClient(5).Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, int32(1), attempts.Load())
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(body), "api.err.InvalidDHCPRange")
+}
+
+func TestRetryTransport_StopsAfterMaxRetries(t *testing.T) {
+	server, attempts := newRetryTestServer(t, func(attempt int32, w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := newRetryTestClient(2).Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	transport := newRetryTransport(http.DefaultTransport, 10, 8*time.Second)
+
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := transport.backoff(attempt, nil)
+		upper := min(time.Second<<attempt, 8*time.Second)
+
+		assert.True(t, wait >= upper/2 && wait <= upper, "attempt %d waited %s", attempt, wait)
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	assert.Equal(t, 8*time.Second, transport.backoff(0, resp))
+}
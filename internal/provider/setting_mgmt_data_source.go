@@ -84,9 +84,7 @@ func (d *settingMgmtDataSource) Read(ctx context.Context, req datasource.ReadReq
 }
 
 func parseSettingMgmtDataSourceJson(ctx context.Context, json unifi.SettingMgmt, model *datasource_setting_mgmt.SettingMgmtModel) diag.Diagnostics {
-	model.Id = types.StringValue(json.ID)
-	model.AutoUpgrade = types.BoolValue(json.AutoUpgrade)
-	model.SshEnabled = types.BoolValue(json.XSshEnabled)
+	mapSettingMgmtDataSourceJson(json, model)
 
 	sshKeyList, diags := types.ListValueFrom(ctx, datasource_setting_mgmt.SshKeysValue{}.Type(ctx), json.XSshKeys)
 	if diags.HasError() {
@@ -18,9 +18,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &settingMgmtResource{}
-	_ resource.ResourceWithConfigure   = &settingMgmtResource{}
-	_ resource.ResourceWithImportState = &settingMgmtResource{}
+	_ resource.Resource                 = &settingMgmtResource{}
+	_ resource.ResourceWithConfigure    = &settingMgmtResource{}
+	_ resource.ResourceWithImportState  = &settingMgmtResource{}
+	_ resource.ResourceWithUpgradeState = &settingMgmtResource{}
 )
 
 func NewSettingMgmtResource() resource.Resource {
@@ -37,6 +38,11 @@ func (r *settingMgmtResource) Metadata(ctx context.Context, req resource.Metadat
 
 func (r *settingMgmtResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_setting_mgmt.SettingMgmtResourceSchema(ctx)
+	resp.Schema.Version = setSchemaVersion
+}
+
+func (r *settingMgmtResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return listToSetStateUpgraders(ctx, settingMgmtSchemaV0(), resource_setting_mgmt.SettingMgmtResourceSchema(ctx))
 }
 
 func (r *settingMgmtResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -66,6 +72,11 @@ func (r *settingMgmtResource) ImportState(ctx context.Context, req resource.Impo
 }
 
 func (r *settingMgmtResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create Setting Mgmt")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_setting_mgmt.SettingMgmtModel
 
 	// Read Terraform plan data into the model
@@ -125,6 +136,11 @@ func (r *settingMgmtResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *settingMgmtResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update Setting Mgmt")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_setting_mgmt.SettingMgmtModel
 
 	// Read Terraform plan data into the model
@@ -133,7 +149,15 @@ func (r *settingMgmtResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	var body unifi.SettingMgmt
+	current, diags := readForUpdate(ctx, r.client, nil, "Setting Mgmt", func() (*unifi.SettingMgmt, error) {
+		return r.client.GetSettingMgmt(ctx, data.Site.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
 	resp.Diagnostics.Append(parseSettingMgmtResourceModel(ctx, data, &body)...)
 	settingMgmt, err := r.client.UpdateSettingMgmt(ctx, data.Site.ValueString(), &body)
 	if err != nil {
@@ -156,6 +180,11 @@ func (r *settingMgmtResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *settingMgmtResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete Setting Mgmt")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_setting_mgmt.SettingMgmtModel
 
 	// Read Terraform prior state data into the model
@@ -176,23 +205,19 @@ func (r *settingMgmtResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func parseSettingMgmtResourceJson(ctx context.Context, json unifi.SettingMgmt, model *resource_setting_mgmt.SettingMgmtModel) diag.Diagnostics {
-	model.Id = types.StringValue(json.ID)
-	model.AutoUpgrade = types.BoolValue(json.AutoUpgrade)
-	model.SshEnabled = types.BoolValue(json.XSshEnabled)
+	mapSettingMgmtResourceJson(json, model)
 
-	sshKeyList, diags := types.ListValueFrom(ctx, resource_setting_mgmt.SshKeysValue{}.Type(ctx), json.XSshKeys)
+	sshKeySet, diags := types.SetValueFrom(ctx, resource_setting_mgmt.SshKeysValue{}.Type(ctx), json.XSshKeys)
 	if diags.HasError() {
 		return diags
 	}
-	model.SshKeys = sshKeyList
+	model.SshKeys = sshKeySet
 
 	return nil
 }
 
 func parseSettingMgmtResourceModel(ctx context.Context, model resource_setting_mgmt.SettingMgmtModel, json *unifi.SettingMgmt) diag.Diagnostics {
-	json.ID = model.Id.ValueString()
-	json.AutoUpgrade = model.AutoUpgrade.ValueBool()
-	json.XSshEnabled = model.SshEnabled.ValueBool()
+	mapSettingMgmtResourceModel(model, json)
 
 	if !model.SshKeys.IsUnknown() && !model.SshKeys.IsNull() {
 		diags := model.SshKeys.ElementsAs(ctx, &json.XSshKeys, false)
@@ -10,7 +10,6 @@ import (
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_setting_radius"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -73,19 +72,8 @@ func (d *settingRadiusDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	parseSettingRadiusDataSourceJson(*settingRadius, &data)
+	mapSettingRadiusDataSourceJson(*settingRadius, &data)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-func parseSettingRadiusDataSourceJson(json unifi.SettingRadius, model *datasource_setting_radius.SettingRadiusModel) {
-	model.Id = types.StringValue(json.ID)
-	model.AccountingEnabled = types.BoolValue(json.AccountingEnabled)
-	model.AccountingPort = types.Int64Value(int64(json.AcctPort))
-	model.AuthPort = types.Int64Value(int64(json.AuthPort))
-	model.Enabled = types.BoolValue(json.Enabled)
-	model.InterimUpdateInterval = types.Int64Value(int64(json.InterimUpdateInterval))
-	model.Secret = types.StringValue(json.XSecret)
-	model.TunneledReply = types.BoolValue(json.TunneledReply)
-}
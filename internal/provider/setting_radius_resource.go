@@ -65,6 +65,11 @@ func (r *settingRadiusResource) ImportState(ctx context.Context, req resource.Im
 }
 
 func (r *settingRadiusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create Setting RADIUS")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_setting_radius.SettingRadiusModel
 
 	// Read Terraform plan data into the model
@@ -74,7 +79,7 @@ func (r *settingRadiusResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	var body unifi.SettingRadius
-	parseSettingRadiusResourceModel(data, &body)
+	mapSettingRadiusResourceModel(data, &body)
 	settingRadius, err := r.client.UpdateSettingRadius(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -84,7 +89,7 @@ func (r *settingRadiusResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	parseSettingRadiusResourceJson(*settingRadius, &data)
+	mapSettingRadiusResourceJson(*settingRadius, &data)
 
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -111,13 +116,18 @@ func (r *settingRadiusResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	parseSettingRadiusResourceJson(*settingRadius, &data)
+	mapSettingRadiusResourceJson(*settingRadius, &data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *settingRadiusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update Setting RADIUS")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_setting_radius.SettingRadiusModel
 
 	// Read Terraform plan data into the model
@@ -126,8 +136,16 @@ func (r *settingRadiusResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	var body unifi.SettingRadius
-	parseSettingRadiusResourceModel(data, &body)
+	current, diags := readForUpdate(ctx, r.client, nil, "Setting RADIUS", func() (*unifi.SettingRadius, error) {
+		return r.client.GetSettingRadius(ctx, data.Site.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	mapSettingRadiusResourceModel(data, &body)
 	settingRadius, err := r.client.UpdateSettingRadius(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -137,7 +155,7 @@ func (r *settingRadiusResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	parseSettingRadiusResourceJson(*settingRadius, &data)
+	mapSettingRadiusResourceJson(*settingRadius, &data)
 
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -146,6 +164,11 @@ func (r *settingRadiusResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *settingRadiusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete Setting RADIUS")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_setting_radius.SettingRadiusModel
 
 	// Read Terraform prior state data into the model
@@ -164,25 +187,3 @@ func (r *settingRadiusResource) Delete(ctx context.Context, req resource.DeleteR
 	// 	return
 	// }
 }
-
-func parseSettingRadiusResourceJson(json unifi.SettingRadius, model *resource_setting_radius.SettingRadiusModel) {
-	model.Id = types.StringValue(json.ID)
-	model.AccountingEnabled = types.BoolValue(json.AccountingEnabled)
-	model.AccountingPort = types.Int64Value(int64(json.AcctPort))
-	model.AuthPort = types.Int64Value(int64(json.AuthPort))
-	model.Enabled = types.BoolValue(json.Enabled)
-	model.InterimUpdateInterval = types.Int64Value(int64(json.InterimUpdateInterval))
-	model.Secret = types.StringValue(json.XSecret)
-	model.TunneledReply = types.BoolValue(json.TunneledReply)
-}
-
-func parseSettingRadiusResourceModel(model resource_setting_radius.SettingRadiusModel, json *unifi.SettingRadius) {
-	json.ID = model.Id.ValueString()
-	json.AccountingEnabled = model.AccountingEnabled.ValueBool()
-	json.AcctPort = int(model.AccountingPort.ValueInt64())
-	json.AuthPort = int(model.AuthPort.ValueInt64())
-	json.Enabled = model.Enabled.ValueBool()
-	json.InterimUpdateInterval = int(model.InterimUpdateInterval.ValueInt64())
-	json.XSecret = model.Secret.ValueString()
-	json.TunneledReply = model.TunneledReply.ValueBool()
-}
@@ -10,8 +10,6 @@ import (
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_setting_usg"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -74,7 +72,7 @@ func (d *settingUsgDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	resp.Diagnostics.Append(parseSettingUsgDataSourceJson(ctx, *settingUsg, &data)...)
+	resp.Diagnostics.Append(mapSettingUsgDataSourceJson(ctx, *settingUsg, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -82,30 +80,3 @@ func (d *settingUsgDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-func parseSettingUsgDataSourceJson(ctx context.Context, json unifi.SettingUsg, model *datasource_setting_usg.SettingUsgModel) diag.Diagnostics {
-	model.Id = types.StringValue(json.ID)
-	model.SiteId = types.StringValue(json.SiteID)
-
-	var dhcpRelayServerSlice []types.String
-	for _, server := range []string{
-		json.DHCPRelayServer1,
-		json.DHCPRelayServer2,
-		json.DHCPRelayServer3,
-		json.DHCPRelayServer4,
-		json.DHCPRelayServer5,
-	} {
-		if server != "" {
-			dhcpRelayServerSlice = append(dhcpRelayServerSlice, types.StringValue(server))
-		}
-	}
-	dhcpRelayServerList, diags := types.ListValueFrom(ctx, types.StringType, dhcpRelayServerSlice)
-	if diags.HasError() {
-		return diags
-	}
-	model.DhcpRelayServers = dhcpRelayServerList
-
-	model.MulticastDnsEnabled = types.BoolValue(json.MdnsEnabled)
-
-	return nil
-}
@@ -10,7 +10,6 @@ import (
 
 	"github.com/zoullx/terraform-provider-unifi/internal/resource_setting_usg"
 
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -66,6 +65,11 @@ func (r *settingUsgResource) ImportState(ctx context.Context, req resource.Impor
 }
 
 func (r *settingUsgResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create Setting USG")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_setting_usg.SettingUsgModel
 
 	// Read Terraform plan data into the model
@@ -75,7 +79,7 @@ func (r *settingUsgResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	var body unifi.SettingUsg
-	resp.Diagnostics.Append(parseSettingUsgResourceModel(ctx, data, &body)...)
+	resp.Diagnostics.Append(mapSettingUsgResourceModel(ctx, data, &body)...)
 	settingUsg, err := r.client.UpdateSettingUsg(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -85,7 +89,7 @@ func (r *settingUsgResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	resp.Diagnostics.Append(parseSettingUsgResourceJson(ctx, *settingUsg, &data)...)
+	resp.Diagnostics.Append(mapSettingUsgResourceJson(ctx, *settingUsg, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -115,7 +119,7 @@ func (r *settingUsgResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(parseSettingUsgResourceJson(ctx, *settingUsg, &data)...)
+	resp.Diagnostics.Append(mapSettingUsgResourceJson(ctx, *settingUsg, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -125,6 +129,11 @@ func (r *settingUsgResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *settingUsgResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update Setting USG")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_setting_usg.SettingUsgModel
 
 	// Read Terraform plan data into the model
@@ -133,8 +142,16 @@ func (r *settingUsgResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	var body unifi.SettingUsg
-	resp.Diagnostics.Append(parseSettingUsgResourceModel(ctx, data, &body)...)
+	current, diags := readForUpdate(ctx, r.client, nil, "Setting USG", func() (*unifi.SettingUsg, error) {
+		return r.client.GetSettingUsg(ctx, data.Site.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	resp.Diagnostics.Append(mapSettingUsgResourceModel(ctx, data, &body)...)
 	settingUsg, err := r.client.UpdateSettingUsg(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -144,7 +161,7 @@ func (r *settingUsgResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	resp.Diagnostics.Append(parseSettingUsgResourceJson(ctx, *settingUsg, &data)...)
+	resp.Diagnostics.Append(mapSettingUsgResourceJson(ctx, *settingUsg, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -156,6 +173,11 @@ func (r *settingUsgResource) Update(ctx context.Context, req resource.UpdateRequ
 }
 
 func (r *settingUsgResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete Setting USG")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_setting_usg.SettingUsgModel
 
 	// Read Terraform prior state data into the model
@@ -174,52 +196,3 @@ func (r *settingUsgResource) Delete(ctx context.Context, req resource.DeleteRequ
 	// 	return
 	// }
 }
-
-func parseSettingUsgResourceJson(ctx context.Context, json unifi.SettingUsg, model *resource_setting_usg.SettingUsgModel) diag.Diagnostics {
-	model.Id = types.StringValue(json.ID)
-	model.SiteId = types.StringValue(json.SiteID)
-
-	var dhcpRelayServerSlice []types.String
-	for _, server := range []string{
-		json.DHCPRelayServer1,
-		json.DHCPRelayServer2,
-		json.DHCPRelayServer3,
-		json.DHCPRelayServer4,
-		json.DHCPRelayServer5,
-	} {
-		if server != "" {
-			dhcpRelayServerSlice = append(dhcpRelayServerSlice, types.StringValue(server))
-		}
-	}
-	dhcpRelayServerList, diags := types.ListValueFrom(ctx, types.StringType, dhcpRelayServerSlice)
-	if diags.HasError() {
-		return diags
-	}
-	model.DhcpRelayServers = dhcpRelayServerList
-
-	model.MulticastDnsEnabled = types.BoolValue(json.MdnsEnabled)
-
-	return nil
-}
-
-func parseSettingUsgResourceModel(ctx context.Context, model resource_setting_usg.SettingUsgModel, json *unifi.SettingUsg) diag.Diagnostics {
-	json.ID = model.Id.ValueString()
-	json.SiteID = model.SiteId.ValueString()
-
-	var dhcpRelayServerSlice []types.String
-	if !model.DhcpRelayServers.IsUnknown() && !model.DhcpRelayServers.IsNull() {
-		diags := model.DhcpRelayServers.ElementsAs(ctx, &dhcpRelayServerSlice, false)
-		if diags.HasError() {
-			return diags
-		}
-	}
-	json.DHCPRelayServer1 = tfStringSliceValueAtIndex(dhcpRelayServerSlice, 0).ValueString()
-	json.DHCPRelayServer2 = tfStringSliceValueAtIndex(dhcpRelayServerSlice, 1).ValueString()
-	json.DHCPRelayServer3 = tfStringSliceValueAtIndex(dhcpRelayServerSlice, 2).ValueString()
-	json.DHCPRelayServer4 = tfStringSliceValueAtIndex(dhcpRelayServerSlice, 3).ValueString()
-	json.DHCPRelayServer5 = tfStringSliceValueAtIndex(dhcpRelayServerSlice, 4).ValueString()
-
-	json.MdnsEnabled = model.MulticastDnsEnabled.ValueBool()
-
-	return nil
-}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -19,6 +18,7 @@ var (
 	_ resource.Resource                = &siteResource{}
 	_ resource.ResourceWithConfigure   = &siteResource{}
 	_ resource.ResourceWithImportState = &siteResource{}
+	_ resource.ResourceWithModifyPlan  = &siteResource{}
 )
 
 func NewSiteResource() resource.Resource {
@@ -63,7 +63,21 @@ func (r *siteResource) ImportState(ctx context.Context, req resource.ImportState
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *siteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Refuse to plan the destroy of a protected Site.
+	if req.Plan.Raw.IsNull() {
+		var prior resource_site.SiteModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+		resp.Diagnostics.Append(checkDeletionProtection(prior.DeletionProtection, "Site")...)
+	}
+}
+
 func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create Site")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_site.SiteModel
 
 	// Read Terraform plan data into the model
@@ -73,7 +87,7 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	var body unifi.Site
-	parseSiteResourceModel(data, &body)
+	mapSiteResourceModel(data, &body)
 	site, err := r.client.CreateSiteByModel(ctx, &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -83,7 +97,7 @@ func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	parseSiteResourceJson(*site, &data)
+	mapSiteResourceJson(*site, &data)
 
 	// data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -110,13 +124,20 @@ func (r *siteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	parseSiteResourceJson(*site, &data)
+	mapSiteResourceJson(*site, &data)
+
+	data.DeletionProtection = deletionProtectionValue(data.DeletionProtection)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update Site")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_site.SiteModel
 
 	// Read Terraform plan data into the model
@@ -125,8 +146,16 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	var body unifi.Site
-	parseSiteResourceModel(data, &body)
+	current, diags := readForUpdate(ctx, r.client, nil, "Site", func() (*unifi.Site, error) {
+		return r.client.GetSite(ctx, data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	mapSiteResourceModel(data, &body)
 	site, err := r.client.UpdateSiteByModel(ctx, &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -136,7 +165,7 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	parseSiteResourceJson(*site, &data)
+	mapSiteResourceJson(*site, &data)
 
 	// data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -145,6 +174,11 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 }
 
 func (r *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete Site")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_site.SiteModel
 
 	// Read Terraform prior state data into the model
@@ -153,6 +187,11 @@ func (r *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(checkDeletionProtection(data.DeletionProtection, "Site")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete existing Site
 	_, err := r.client.DeleteSite(ctx, data.Id.ValueString())
 	if err != nil {
@@ -163,15 +202,3 @@ func (r *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 }
-
-func parseSiteResourceJson(json unifi.Site, model *resource_site.SiteModel) {
-	model.Id = types.StringValue(json.ID)
-	model.Name = types.StringValue(json.Name)
-	model.Description = types.StringValue(json.Description)
-}
-
-func parseSiteResourceModel(model resource_site.SiteModel, json *unifi.Site) {
-	json.ID = model.Id.ValueString()
-	json.Name = model.Name.ValueString()
-	json.Description = model.Description.ValueString()
-}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,7 +83,7 @@ func (d *sitesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 }
 
 func parseSitesDataSourceJson(ctx context.Context, json []unifi.Site, model *datasource_sites.SitesModel) diag.Diagnostics {
-	siteList, diags := types.ListValueFrom(ctx, datasource_sites.SitesValue{}.Type(ctx), json)
+	siteList, diags := objectListValue(ctx, datasource_sites.SitesValue{}.Type(ctx), json, mapSitesDataSourceJson)
 	if diags.HasError() {
 		return diags
 	}
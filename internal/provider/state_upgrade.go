@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// setSchemaVersion is the schema version of resources whose unordered list
+// attributes, e.g. firewall group members, were converted to sets.
+const setSchemaVersion = 1
+
+// listToSetStateUpgraders upgrades the state of a resource from before its
+// list attributes were converted to sets. The prior state is decoded with the
+// pinned version 0 schema prior and converted to the current schema, see
+// upgradeStateValue.
+func listToSetStateUpgraders(ctx context.Context, prior *schema.Schema, current schema.Schema) map[int64]resource.StateUpgrader {
+	stateType := current.Type().TerraformType(ctx)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: prior,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.State == nil {
+					resp.Diagnostics.AddError(
+						"Error Upgrading State",
+						"Could not upgrade the state from schema version 0, no prior state was provided.",
+					)
+					return
+				}
+
+				raw, err := upgradeStateValue(req.State.Raw, stateType)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Error Upgrading State",
+						"Could not upgrade the state from schema version 0, unexpected error: "+err.Error(),
+					)
+					return
+				}
+
+				resp.State.Raw = raw
+			},
+		},
+	}
+}
+
+// upgradeStateValue converts a value of a prior schema to typ. Lists become
+// sets, attributes missing from the prior schema are null and attributes
+// removed since are dropped. Other type changes are reported as errors.
+func upgradeStateValue(value tftypes.Value, typ tftypes.Type) (tftypes.Value, error) {
+	if value.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !value.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var elemType tftypes.Type
+	switch t := typ.(type) {
+	case tftypes.List:
+		elemType = t.ElementType
+	case tftypes.Set:
+		elemType = t.ElementType
+	case tftypes.Object:
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			return tftypes.Value{}, fmt.Errorf("converting %s to %s: %w", value.Type(), typ, err)
+		}
+
+		upgraded := make(map[string]tftypes.Value, len(t.AttributeTypes))
+		for name, attributeType := range t.AttributeTypes {
+			attribute, ok := attributes[name]
+			if !ok {
+				upgraded[name] = tftypes.NewValue(attributeType, nil)
+				continue
+			}
+
+			var err error
+			upgraded[name], err = upgradeStateValue(attribute, attributeType)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+			}
+		}
+		return tftypes.NewValue(typ, upgraded), nil
+	default:
+		if !value.Type().Equal(typ) {
+			return tftypes.Value{}, fmt.Errorf("cannot convert %s to %s", value.Type(), typ)
+		}
+		return value, nil
+	}
+
+	var elements []tftypes.Value
+	if err := value.As(&elements); err != nil {
+		return tftypes.Value{}, fmt.Errorf("converting %s to %s: %w", value.Type(), typ, err)
+	}
+
+	upgraded := make([]tftypes.Value, len(elements))
+	for i, element := range elements {
+		var err error
+		upgraded[i], err = upgradeStateValue(element, elemType)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+	}
+	return tftypes.NewValue(typ, upgraded), nil
+}
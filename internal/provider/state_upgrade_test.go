@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_ap_group"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_firewall_group"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_firewall_rule"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_setting_mgmt"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_wlan"
+)
+
+func TestListToSetStateUpgraders(t *testing.T) {
+	ctx := context.Background()
+	current := resource_firewall_group.FirewallGroupResourceSchema(ctx)
+	prior := firewallGroupSchemaV0()
+	upgrader := listToSetStateUpgraders(ctx, prior, current)[0]
+	assert.Equal(t, prior, upgrader.PriorSchema)
+
+	// A version 0 state with members stored as a list and an attribute that
+	// no longer exists, decoded with the prior schema as the framework does.
+	raw, err := (&tfprotov6.RawState{
+		JSON: []byte(`{"id":"group-1","name":"admins","site":"default","type":"address-group",` +
+			`"members":["10.0.0.2","10.0.0.1"],"removed":"value"}`),
+	}).UnmarshalWithOpts(prior.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	})
+	assert.NoError(t, err)
+
+	req := resource.UpgradeStateRequest{
+		State: &tfsdk.State{
+			Schema: prior,
+			Raw:    raw,
+		},
+	}
+	resp := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: current,
+			Raw:    tftypes.NewValue(current.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	upgrader.StateUpgrader(ctx, req, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model resource_firewall_group.FirewallGroupModel
+	assert.False(t, resp.State.Get(ctx, &model).HasError())
+
+	var members []string
+	assert.False(t, model.Members.ElementsAs(ctx, &members, false).HasError())
+	slices.Sort(members)
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, members)
+	assert.Equal(t, types.StringValue("admins"), model.Name)
+	assert.True(t, model.SiteId.IsNull())
+}
+
+func TestListToSetStateUpgraders_PriorSchemas(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		prior   *schema.Schema
+		current schema.Schema
+	}{
+		"ap_group":       {apGroupSchemaV0(), resource_ap_group.ApGroupResourceSchema(ctx)},
+		"firewall_group": {firewallGroupSchemaV0(), resource_firewall_group.FirewallGroupResourceSchema(ctx)},
+		"firewall_rule":  {firewallRuleSchemaV0(), resource_firewall_rule.FirewallRuleResourceSchema(ctx)},
+		"setting_mgmt":   {settingMgmtSchemaV0(), resource_setting_mgmt.SettingMgmtResourceSchema(ctx)},
+		"wlan":           {wlanSchemaV0(), resource_wlan.WlanResourceSchema(ctx)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			priorType := tt.prior.Type().TerraformType(ctx).(tftypes.Object)
+
+			// Every attribute of the prior schema set to an empty or zero
+			// value, so each one has to convert to the current type.
+			attributes := make(map[string]tftypes.Value, len(priorType.AttributeTypes))
+			for name, typ := range priorType.AttributeTypes {
+				attributes[name] = zeroStateValue(typ)
+			}
+
+			_, err := upgradeStateValue(tftypes.NewValue(priorType, attributes), tt.current.Type().TerraformType(ctx))
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUpgradeStateValue_TypeChange(t *testing.T) {
+	prior := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"port": tftypes.String}}
+	current := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"port": tftypes.Number}}
+
+	_, err := upgradeStateValue(tftypes.NewValue(prior, map[string]tftypes.Value{
+		"port": tftypes.NewValue(tftypes.String, "80"),
+	}), current)
+	assert.ErrorContains(t, err, "port: cannot convert")
+}
+
+// zeroStateValue returns a known value of typ, with one element in
+// collections.
+func zeroStateValue(typ tftypes.Type) tftypes.Value {
+	switch t := typ.(type) {
+	case tftypes.List:
+		return tftypes.NewValue(t, []tftypes.Value{zeroStateValue(t.ElementType)})
+	case tftypes.Set:
+		return tftypes.NewValue(t, []tftypes.Value{zeroStateValue(t.ElementType)})
+	case tftypes.Object:
+		attributes := make(map[string]tftypes.Value, len(t.AttributeTypes))
+		for name, attributeType := range t.AttributeTypes {
+			attributes[name] = zeroStateValue(attributeType)
+		}
+		return tftypes.NewValue(t, attributes)
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		return tftypes.NewValue(typ, false)
+	case typ.Is(tftypes.Number):
+		return tftypes.NewValue(typ, 0)
+	default:
+		return tftypes.NewValue(typ, "")
+	}
+}
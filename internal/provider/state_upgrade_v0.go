@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The schemas below are frozen copies of the attribute types before the
+// conversion to sets. They are only used to decode version 0 state and must
+// not be changed when the resource schemas change.
+
+// apGroupSchemaV0 is the version 0 schema of unifi_ap_group, with
+// device_macs stored as a list.
+func apGroupSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"device_macs":  schema.ListAttribute{ElementType: types.StringType, Required: true},
+			"id":           schema.StringAttribute{Computed: true},
+			"last_updated": schema.StringAttribute{Computed: true},
+			"name":         schema.StringAttribute{Required: true},
+			"site":         schema.StringAttribute{Required: true},
+		},
+	}
+}
+
+// firewallGroupSchemaV0 is the version 0 schema of unifi_firewall_group,
+// with members stored as a list.
+func firewallGroupSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":           schema.StringAttribute{Computed: true},
+			"last_updated": schema.StringAttribute{Computed: true},
+			"members":      schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"name":         schema.StringAttribute{Required: true},
+			"site":         schema.StringAttribute{Required: true},
+			"site_id":      schema.StringAttribute{Computed: true},
+			"type":         schema.StringAttribute{Optional: true, Computed: true},
+		},
+	}
+}
+
+// firewallRuleSchemaV0 is the version 0 schema of unifi_firewall_rule, with
+// the firewall group ids stored as lists.
+func firewallRuleSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"action":                  schema.StringAttribute{Optional: true, Computed: true},
+			"dst_address":             schema.StringAttribute{Optional: true, Computed: true},
+			"dst_address_ipv6":        schema.StringAttribute{Optional: true, Computed: true},
+			"dst_firewall_group_ids":  schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"dst_network_id":          schema.StringAttribute{Optional: true, Computed: true},
+			"dst_network_type":        schema.StringAttribute{Optional: true, Computed: true},
+			"dst_port":                schema.StringAttribute{Optional: true, Computed: true},
+			"enabled":                 schema.BoolAttribute{Optional: true, Computed: true},
+			"icmp_typename":           schema.StringAttribute{Optional: true, Computed: true},
+			"icmp_v6_typename":        schema.StringAttribute{Optional: true, Computed: true},
+			"id":                      schema.StringAttribute{Computed: true},
+			"ip_sec":                  schema.StringAttribute{Optional: true, Computed: true},
+			"last_updated":            schema.StringAttribute{Computed: true},
+			"logging":                 schema.BoolAttribute{Optional: true, Computed: true},
+			"name":                    schema.StringAttribute{Required: true},
+			"protocol":                schema.StringAttribute{Optional: true, Computed: true},
+			"protocol_match_excepted": schema.BoolAttribute{Optional: true, Computed: true},
+			"protocol_v6":             schema.StringAttribute{Optional: true, Computed: true},
+			"rule_index":              schema.Int64Attribute{Optional: true, Computed: true},
+			"ruleset":                 schema.StringAttribute{Optional: true, Computed: true},
+			"setting_preference":      schema.StringAttribute{Optional: true, Computed: true},
+			"site":                    schema.StringAttribute{Required: true},
+			"site_id":                 schema.StringAttribute{Computed: true},
+			"src_address":             schema.StringAttribute{Optional: true, Computed: true},
+			"src_address_ipv6":        schema.StringAttribute{Optional: true, Computed: true},
+			"src_firewall_group_ids":  schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"src_mac":                 schema.StringAttribute{Optional: true, Computed: true},
+			"src_network_id":          schema.StringAttribute{Optional: true, Computed: true},
+			"src_network_type":        schema.StringAttribute{Optional: true, Computed: true},
+			"src_port":                schema.StringAttribute{Optional: true, Computed: true},
+			"state_established":       schema.BoolAttribute{Optional: true, Computed: true},
+			"state_invalid":           schema.BoolAttribute{Optional: true, Computed: true},
+			"state_new":               schema.BoolAttribute{Optional: true, Computed: true},
+			"state_related":           schema.BoolAttribute{Optional: true, Computed: true},
+		},
+	}
+}
+
+// settingMgmtSchemaV0 is the version 0 schema of unifi_setting_mgmt, with
+// ssh_keys stored as a list.
+func settingMgmtSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"auto_upgrade": schema.BoolAttribute{Optional: true, Computed: true},
+			"id":           schema.StringAttribute{Computed: true},
+			"last_updated": schema.StringAttribute{Computed: true},
+			"site":         schema.StringAttribute{Required: true},
+			"site_id":      schema.StringAttribute{Computed: true},
+			"ssh_enabled":  schema.BoolAttribute{Optional: true, Computed: true},
+			"ssh_keys": schema.ListAttribute{ElementType: types.ObjectType{AttrTypes: map[string]attr.Type{
+				"comment": types.StringType,
+				"key":     types.StringType,
+				"name":    types.StringType,
+				"type":    types.StringType,
+			}}, Optional: true, Computed: true},
+		},
+	}
+}
+
+// wlanSchemaV0 is the version 0 schema of unifi_wlan, with its unordered
+// collections stored as lists.
+func wlanSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ap_group_ids":                         schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"ap_group_mode":                        schema.StringAttribute{Optional: true, Computed: true},
+			"b_supported":                          schema.BoolAttribute{Optional: true, Computed: true},
+			"broadcast_filter_list":                schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"bss_transition":                       schema.BoolAttribute{Optional: true, Computed: true},
+			"dtim_2g":                              schema.Int64Attribute{Optional: true, Computed: true},
+			"dtim_5g":                              schema.Int64Attribute{Optional: true, Computed: true},
+			"dtim_6e":                              schema.Int64Attribute{Optional: true, Computed: true},
+			"dtim_mode":                            schema.StringAttribute{Optional: true, Computed: true},
+			"enabled":                              schema.BoolAttribute{Optional: true, Computed: true},
+			"enhanced_iot":                         schema.BoolAttribute{Optional: true, Computed: true},
+			"fast_roaming_enabled":                 schema.BoolAttribute{Optional: true, Computed: true},
+			"group_rekey":                          schema.Int64Attribute{Optional: true, Computed: true},
+			"hide_ssid":                            schema.BoolAttribute{Optional: true, Computed: true},
+			"hotspot2conf_enabled":                 schema.BoolAttribute{Optional: true, Computed: true},
+			"iapp_enabled":                         schema.BoolAttribute{Optional: true, Computed: true},
+			"iapp_key":                             schema.StringAttribute{Optional: true, Computed: true},
+			"id":                                   schema.StringAttribute{Computed: true},
+			"is_guest":                             schema.BoolAttribute{Optional: true, Computed: true},
+			"l2_isolation":                         schema.BoolAttribute{Optional: true, Computed: true},
+			"last_updated":                         schema.StringAttribute{Computed: true},
+			"mac_filter_enabled":                   schema.BoolAttribute{Optional: true, Computed: true},
+			"mac_filter_list":                      schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"mac_filter_policy":                    schema.StringAttribute{Optional: true, Computed: true},
+			"minimum_2g_advertising_rates":         schema.BoolAttribute{Optional: true, Computed: true},
+			"minimum_2g_data_rate_enabled":         schema.BoolAttribute{Optional: true, Computed: true},
+			"minimum_2g_data_rate_kbps":            schema.Int64Attribute{Optional: true, Computed: true},
+			"minimum_5g_advertising_rates":         schema.BoolAttribute{Optional: true, Computed: true},
+			"minimum_5g_data_rate_enabled":         schema.BoolAttribute{Optional: true, Computed: true},
+			"minimum_5g_data_rate_kbps":            schema.Int64Attribute{Optional: true, Computed: true},
+			"minimum_data_rate_setting_preference": schema.StringAttribute{Optional: true, Computed: true},
+			"mlo_enabled":                          schema.BoolAttribute{Optional: true, Computed: true},
+			"multicast_enhance_enabled":            schema.BoolAttribute{Optional: true, Computed: true},
+			"name":                                 schema.StringAttribute{Required: true},
+			"network_id":                           schema.StringAttribute{Optional: true, Computed: true},
+			"no2ghz_oui":                           schema.BoolAttribute{Optional: true, Computed: true},
+			"optimize_iot_wifi_connectivity":       schema.BoolAttribute{Optional: true, Computed: true},
+			"passphrase":                           schema.StringAttribute{Optional: true, Computed: true},
+			"passphrase_autogenerated":             schema.BoolAttribute{Optional: true, Computed: true},
+			"pmf_mode":                             schema.StringAttribute{Optional: true, Computed: true},
+			"private_preshared_keys": schema.ListAttribute{ElementType: types.ObjectType{AttrTypes: map[string]attr.Type{
+				"network_id": types.StringType,
+				"password":   types.StringType,
+			}}, Optional: true, Computed: true},
+			"private_preshared_keys_enabled": schema.BoolAttribute{Optional: true, Computed: true},
+			"proxy_arp":                      schema.BoolAttribute{Optional: true, Computed: true},
+			"radius_das_enabled":             schema.BoolAttribute{Optional: true, Computed: true},
+			"radius_mac_acl_format":          schema.StringAttribute{Optional: true, Computed: true},
+			"radius_mac_auth_enabled":        schema.BoolAttribute{Optional: true, Computed: true},
+			"radius_profile_id":              schema.StringAttribute{Optional: true, Computed: true},
+			"sae_groups":                     schema.ListAttribute{ElementType: types.Int64Type, Optional: true, Computed: true},
+			"sae_psks": schema.ListAttribute{ElementType: types.ObjectType{AttrTypes: map[string]attr.Type{
+				"id":   types.StringType,
+				"mac":  types.StringType,
+				"psk":  types.StringType,
+				"vlan": types.Int64Type,
+			}}, Optional: true, Computed: true},
+			"schedule": schema.ListAttribute{ElementType: types.ObjectType{AttrTypes: map[string]attr.Type{
+				"day_of_week":  types.StringType,
+				"duration":     types.Int64Type,
+				"name":         types.StringType,
+				"start_hour":   types.Int64Type,
+				"start_minute": types.Int64Type,
+			}}, Optional: true, Computed: true},
+			"security":           schema.StringAttribute{Optional: true, Computed: true},
+			"setting_preference": schema.StringAttribute{Optional: true, Computed: true},
+			"site":               schema.StringAttribute{Required: true},
+			"site_id":            schema.StringAttribute{Computed: true},
+			"uapsd_enabled":      schema.BoolAttribute{Optional: true, Computed: true},
+			"user_group_id":      schema.StringAttribute{Optional: true, Computed: true},
+			"wlan_band":          schema.StringAttribute{Optional: true, Computed: true},
+			"wlan_bands":         schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"wpa3_enhanced_192":  schema.BoolAttribute{Optional: true, Computed: true},
+			"wpa3_fast_roaming":  schema.BoolAttribute{Optional: true, Computed: true},
+			"wpa3_support":       schema.BoolAttribute{Optional: true, Computed: true},
+			"wpa3_transition":    schema.BoolAttribute{Optional: true, Computed: true},
+			"wpa_enc":            schema.StringAttribute{Optional: true, Computed: true},
+			"wpa_mode":           schema.StringAttribute{Optional: true, Computed: true},
+		},
+	}
+}
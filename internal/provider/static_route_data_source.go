@@ -10,7 +10,6 @@ import (
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_static_route"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -73,18 +72,8 @@ func (d *staticRouteDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	parseStaticRouteDataSourceJson(*staticRoute, &data)
+	mapStaticRouteDataSourceJson(*staticRoute, &data)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-func parseStaticRouteDataSourceJson(json unifi.Routing, model *datasource_static_route.StaticRouteModel) {
-	model.Id = types.StringValue(json.ID)
-	model.Distance = types.Int64Value(int64(json.StaticRouteDistance))
-	model.Interface = types.StringValue(json.StaticRouteInterface)
-	model.Name = types.StringValue(json.Name)
-	model.Network = types.StringValue(json.StaticRouteNetwork)
-	model.NextHop = types.StringValue(json.StaticRouteNexthop)
-	model.Type = types.StringValue(json.StaticRouteType)
-}
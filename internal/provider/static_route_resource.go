@@ -6,13 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/zoullx/terraform-provider-unifi/internal/resource_static_route"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -60,9 +58,8 @@ func (r *staticRouteResource) Configure(ctx context.Context, req resource.Config
 }
 
 func (r *staticRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
 			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
@@ -70,11 +67,16 @@ func (r *staticRouteResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (r *staticRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create Static Route")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_static_route.StaticRouteModel
 
 	// Read Terraform plan data into the model
@@ -84,7 +86,7 @@ func (r *staticRouteResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	var body unifi.Routing
-	parseStaticRouteResourceModel(data, &body)
+	mapStaticRouteResourceModel(data, &body)
 	staticRoute, err := r.client.CreateRouting(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -94,7 +96,7 @@ func (r *staticRouteResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	parseStaticRouteResourceJson(*staticRoute, &data)
+	mapStaticRouteResourceJson(*staticRoute, &data)
 
 	// data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -121,13 +123,18 @@ func (r *staticRouteResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	parseStaticRouteResourceJson(*staticRoute, &data)
+	mapStaticRouteResourceJson(*staticRoute, &data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *staticRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update Static Route")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_static_route.StaticRouteModel
 
 	// Read Terraform plan data into the model
@@ -136,8 +143,16 @@ func (r *staticRouteResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	var body unifi.Routing
-	parseStaticRouteResourceModel(data, &body)
+	current, diags := readForUpdate(ctx, r.client, nil, "Static Route", func() (*unifi.Routing, error) {
+		return r.client.GetRouting(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	mapStaticRouteResourceModel(data, &body)
 	staticRoute, err := r.client.UpdateRouting(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -147,7 +162,7 @@ func (r *staticRouteResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	parseStaticRouteResourceJson(*staticRoute, &data)
+	mapStaticRouteResourceJson(*staticRoute, &data)
 
 	// data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -156,6 +171,11 @@ func (r *staticRouteResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *staticRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete Static Route")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_static_route.StaticRouteModel
 
 	// Read Terraform prior state data into the model
@@ -174,23 +194,3 @@ func (r *staticRouteResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 }
-
-func parseStaticRouteResourceJson(json unifi.Routing, model *resource_static_route.StaticRouteModel) {
-	model.Id = types.StringValue(json.ID)
-	model.Distance = types.Int64Value(int64(json.StaticRouteDistance))
-	model.Interface = types.StringValue(json.StaticRouteInterface)
-	model.Name = types.StringValue(json.Name)
-	model.Network = types.StringValue(json.StaticRouteNetwork)
-	model.NextHop = types.StringValue(json.StaticRouteNexthop)
-	model.Type = types.StringValue(json.StaticRouteType)
-}
-
-func parseStaticRouteResourceModel(model resource_static_route.StaticRouteModel, json *unifi.Routing) {
-	json.ID = model.Id.ValueString()
-	json.StaticRouteDistance = int(model.Distance.ValueInt64())
-	json.StaticRouteInterface = model.Interface.ValueString()
-	json.Name = model.Name.ValueString()
-	json.StaticRouteNetwork = model.Network.ValueString()
-	json.StaticRouteNexthop = model.NextHop.ValueString()
-	json.StaticRouteType = model.Type.ValueString()
-}
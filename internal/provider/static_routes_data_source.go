@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,7 +83,7 @@ func (d *staticRoutesDataSource) Read(ctx context.Context, req datasource.ReadRe
 }
 
 func parseStaticRoutesDataSourceJson(ctx context.Context, json []unifi.Routing, model *datasource_static_routes.StaticRoutesModel) diag.Diagnostics {
-	staticRouteList, diags := types.ListValueFrom(ctx, datasource_static_routes.StaticRoutesValue{}.Type(ctx), json)
+	staticRouteList, diags := objectListValue(ctx, datasource_static_routes.StaticRoutesValue{}.Type(ctx), json, mapStaticRoutesDataSourceJson)
 	if diags.HasError() {
 		return diags
 	}
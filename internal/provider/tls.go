@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// tlsOptions holds the TLS related provider configuration used to verify the
+// Unifi Controller and to authenticate against it.
+type tlsOptions struct {
+	insecure          bool
+	caCertificate     string
+	serverName        string
+	clientCertificate string
+	clientKey         string
+}
+
+// buildTLSConfig returns the TLS configuration for connecting to the Unifi
+// Controller. Certificates and keys may be given either as PEM encoded
+// content or as a path to a file containing it.
+func buildTLSConfig(opts tlsOptions) (*tls.Config, error) {
+	config := &tls.Config{
+		InsecureSkipVerify: opts.insecure,
+		ServerName:         opts.serverName,
+	}
+
+	if opts.caCertificate != "" {
+		caPEM, err := readPEMOrFile(opts.caCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA certificate: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("CA certificate does not contain any valid PEM encoded certificates")
+		}
+		config.RootCAs = pool
+	}
+
+	if (opts.clientCertificate == "") != (opts.clientKey == "") {
+		return nil, errors.New("client certificate and client key must be set together")
+	}
+
+	if opts.clientCertificate != "" {
+		certPEM, err := readPEMOrFile(opts.clientCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client certificate: %w", err)
+		}
+
+		keyPEM, err := readPEMOrFile(opts.clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client key: %w", err)
+		}
+
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// readPEMOrFile returns value as is when it contains PEM encoded content,
+// otherwise it treats value as a file path and returns the file contents.
+func readPEMOrFile(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func generateTestCertificate(t *testing.T) (certPEM, keyPEM string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "unifi.example.com"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	certPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return certPEM, keyPEM
+}
+
+func TestBuildTLSConfig_Defaults(t *testing.T) {
+	config, err := buildTLSConfig(tlsOptions{})
+
+	assert.NoError(t, err)
+	assert.False(t, config.InsecureSkipVerify)
+	assert.Nil(t, config.RootCAs)
+	assert.Empty(t, config.Certificates)
+}
+
+func TestBuildTLSConfig_CACertificateFromPEMAndFile(t *testing.T) {
+	certPEM, _ := generateTestCertificate(t)
+
+	config, err := buildTLSConfig(tlsOptions{caCertificate: certPEM, serverName: "unifi.example.com"})
+	assert.NoError(t, err)
+	assert.NotNil(t, config.RootCAs)
+	assert.Equal(t, "unifi.example.com", config.ServerName)
+
+	file := filepath.Join(t.TempDir(), "ca.pem")
+	assert.NoError(t, os.WriteFile(file, []byte(certPEM), 0o600))
+
+	config, err = buildTLSConfig(tlsOptions{caCertificate: file})
+	assert.NoError(t, err)
+	assert.NotNil(t, config.RootCAs)
+}
+
+func TestBuildTLSConfig_InvalidCACertificate(t *testing.T) {
+	_, err := buildTLSConfig(tlsOptions{caCertificate: "-----BEGIN CERTIFICATE-----\nnope\n-----END CERTIFICATE-----\n"})
+	assert.Error(t, err)
+
+	_, err = buildTLSConfig(tlsOptions{caCertificate: filepath.Join(t.TempDir(), "missing.pem")})
+	assert.Error(t, err)
+}
+
+func TestBuildTLSConfig_ClientCertificate(t *testing.T) {
+	certPEM, keyPEM := generateTestCertificate(t)
+
+	config, err := buildTLSConfig(tlsOptions{clientCertificate: certPEM, clientKey: keyPEM})
+	assert.NoError(t, err)
+	assert.Len(t, config.Certificates, 1)
+
+	_, err = buildTLSConfig(tlsOptions{clientCertificate: certPEM})
+	assert.ErrorContains(t, err, "must be set together")
+}
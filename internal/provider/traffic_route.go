@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+)
+
+// trafficRoute is a traffic route of the v2 API. The settings trafficRoute
+// doesn't model are kept in other and sent back unchanged, as an update
+// replaces the whole route.
+type trafficRoute struct {
+	ID                string                     `json:"_id,omitempty"`
+	SiteID            string                     `json:"site_id,omitempty"`
+	Description       string                     `json:"description"`
+	Enabled           bool                       `json:"enabled"`
+	MatchingTarget    string                     `json:"matching_target,omitempty"`
+	Domains           []trafficRouteDomain       `json:"domains"`
+	IPAddresses       []trafficRouteIPAddress    `json:"ip_addresses"`
+	Regions           []string                   `json:"regions"`
+	TargetDevices     []trafficRouteTargetDevice `json:"target_devices"`
+	NetworkID         string                     `json:"network_id,omitempty"`
+	KillSwitchEnabled bool                       `json:"kill_switch_enabled"`
+
+	// other holds the settings trafficRoute doesn't model, by JSON key.
+	other map[string]json.RawMessage
+}
+
+type trafficRouteDomain struct {
+	Domain string `json:"domain"`
+}
+
+type trafficRouteIPAddress struct {
+	IPOrSubnet string `json:"ip_or_subnet"`
+}
+
+type trafficRouteTargetDevice struct {
+	Type      string `json:"type"`
+	ClientMAC string `json:"client_mac,omitempty"`
+	NetworkID string `json:"network_id,omitempty"`
+}
+
+// trafficRouteFields has the fields of trafficRoute without its JSON methods.
+type trafficRouteFields trafficRoute
+
+// trafficRouteKeys are the JSON keys of the settings modeled by trafficRoute.
+var trafficRouteKeys = jsonKeys(reflect.TypeOf(trafficRouteFields{}))
+
+func (r trafficRoute) MarshalJSON() ([]byte, error) {
+	return marshalWithOther(trafficRouteFields(r), r.other)
+}
+
+func (r *trafficRoute) UnmarshalJSON(b []byte) error {
+	other, err := unmarshalWithOther(b, (*trafficRouteFields)(r), trafficRouteKeys)
+	if err != nil {
+		return err
+	}
+	r.other = other
+
+	return nil
+}
+
+func trafficRoutesPath(site, id string) string {
+	apiPath := "v2/api/site/" + url.PathEscape(site) + "/trafficroutes"
+	if id != "" {
+		apiPath += "/" + url.PathEscape(id)
+	}
+	return apiPath
+}
+
+func (c *apiClient) getTrafficRoute(ctx context.Context, site, id string) (*trafficRoute, error) {
+	// The v2 API only lists the traffic routes of a site.
+	var routes []trafficRoute
+	if err := c.do(ctx, http.MethodGet, trafficRoutesPath(site, ""), nil, &routes); err != nil {
+		return nil, err
+	}
+
+	for _, route := range routes {
+		if route.ID == id {
+			return &route, nil
+		}
+	}
+
+	return nil, fmt.Errorf("traffic route %s not found", id)
+}
+
+func (c *apiClient) createTrafficRoute(ctx context.Context, site string, route *trafficRoute) (*trafficRoute, error) {
+	var created trafficRoute
+	if err := c.do(ctx, http.MethodPost, trafficRoutesPath(site, ""), route, &created); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (c *apiClient) updateTrafficRoute(ctx context.Context, site string, route *trafficRoute) (*trafficRoute, error) {
+	var updated trafficRoute
+	if err := c.do(ctx, http.MethodPut, trafficRoutesPath(site, route.ID), route, &updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (c *apiClient) deleteTrafficRoute(ctx context.Context, site, id string) error {
+	return c.do(ctx, http.MethodDelete, trafficRoutesPath(site, id), nil, nil)
+}
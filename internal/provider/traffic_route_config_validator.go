@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_traffic_route"
+)
+
+var _ resource.ConfigValidator = trafficRouteConfigValidator{}
+
+// trafficRouteConfigValidator checks that the domains, addresses or regions
+// of a unifi_traffic_route match its matching_target.
+type trafficRouteConfigValidator struct{}
+
+func (v trafficRouteConfigValidator) Description(_ context.Context) string {
+	return "The routed domains, addresses or regions must match matching_target"
+}
+
+func (v trafficRouteConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v trafficRouteConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resource_traffic_route.TrafficRouteModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes := map[string]attr.Value{
+		"domains":      data.Domains,
+		"ip_addresses": data.IpAddresses,
+		"regions":      data.Regions,
+	}
+	targets := map[string]string{
+		"domains":      "domain",
+		"ip_addresses": "ip",
+		"regions":      "region",
+	}
+
+	resp.Diagnostics.Append(validateMatchingTarget(data.MatchingTarget, attributes, targets, "Traffic Route")...)
+}
@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_traffic_route"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &trafficRouteResource{}
+	_ resource.ResourceWithConfigure        = &trafficRouteResource{}
+	_ resource.ResourceWithConfigValidators = &trafficRouteResource{}
+	_ resource.ResourceWithImportState      = &trafficRouteResource{}
+	_ resource.ResourceWithModifyPlan       = &trafficRouteResource{}
+)
+
+func NewTrafficRouteResource() resource.Resource {
+	return &trafficRouteResource{}
+}
+
+type trafficRouteResource struct {
+	client *unifiClient
+}
+
+func (r *trafficRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_traffic_route"
+}
+
+func (r *trafficRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_traffic_route.TrafficRouteResourceSchema(ctx)
+}
+
+func (r *trafficRouteResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		trafficRouteConfigValidator{},
+	}
+}
+
+func (r *trafficRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nill check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *trafficRouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Traffic routes are only available through the v2 API, check the controller
+	// supports it before creating one.
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var site types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("site"), &site)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(requireControllerCapability(ctx, r.client, site.ValueString(), capabilityV2Api, path.Empty())...)
+}
+
+func (r *trafficRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *trafficRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create Traffic Route")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_traffic_route.TrafficRouteModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var body trafficRoute
+	resp.Diagnostics.Append(parseTrafficRouteResourceModel(ctx, data, &body)...)
+	route, err := r.client.api.createTrafficRoute(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Traffic Route",
+			"Could not create Traffic Route, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseTrafficRouteResourceJson(ctx, *route, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *route)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *trafficRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_traffic_route.TrafficRouteModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed Traffic Route value from Unifi
+	route, err := r.client.api.getTrafficRoute(ctx, data.Site.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Traffic Route",
+			"Could not read Traffic Route ID "+data.Id.ValueString()+"; "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseTrafficRouteResourceJson(ctx, *route, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *route)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *trafficRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update Traffic Route")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_traffic_route.TrafficRouteModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := readForUpdate(ctx, r.client, req.Private, "Traffic Route", func() (*trafficRoute, error) {
+		return r.client.api.getTrafficRoute(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	resp.Diagnostics.Append(parseTrafficRouteResourceModel(ctx, data, &body)...)
+	route, err := r.client.api.updateTrafficRoute(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Traffic Route",
+			"Could not update Traffic Route, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseTrafficRouteResourceJson(ctx, *route, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *route)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *trafficRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete Traffic Route")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_traffic_route.TrafficRouteModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing Traffic Route
+	err := r.client.api.deleteTrafficRoute(ctx, data.Site.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Traffic Route",
+			"Could not delete Traffic Route, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func parseTrafficRouteResourceJson(ctx context.Context, json trafficRoute, model *resource_traffic_route.TrafficRouteModel) diag.Diagnostics {
+	diags := mapTrafficRouteResourceJson(ctx, json, model)
+
+	model.MatchingTarget = types.StringValue(strings.ToLower(json.MatchingTarget))
+
+	domains := make([]string, 0, len(json.Domains))
+	for _, domain := range json.Domains {
+		domains = append(domains, domain.Domain)
+	}
+	ipAddresses := make([]string, 0, len(json.IPAddresses))
+	for _, ipAddress := range json.IPAddresses {
+		ipAddresses = append(ipAddresses, ipAddress.IPOrSubnet)
+	}
+
+	networkIDs, clientMACs := []string{}, []string{}
+	for _, target := range json.TargetDevices {
+		switch target.Type {
+		case "NETWORK":
+			networkIDs = append(networkIDs, target.NetworkID)
+		case "CLIENT":
+			clientMACs = append(clientMACs, target.ClientMAC)
+		}
+	}
+
+	var d diag.Diagnostics
+	model.Domains, d = types.SetValueFrom(ctx, types.StringType, domains)
+	diags.Append(d...)
+	model.IpAddresses, d = types.SetValueFrom(ctx, types.StringType, ipAddresses)
+	diags.Append(d...)
+	model.NetworkIds, d = types.SetValueFrom(ctx, types.StringType, networkIDs)
+	diags.Append(d...)
+	model.ClientMacs, d = types.SetValueFrom(ctx, customtypes.MacAddressType{}, clientMACs)
+	diags.Append(d...)
+
+	return diags
+}
+
+func parseTrafficRouteResourceModel(ctx context.Context, model resource_traffic_route.TrafficRouteModel, json *trafficRoute) diag.Diagnostics {
+	diags := mapTrafficRouteResourceModel(ctx, model, json)
+
+	json.MatchingTarget = strings.ToUpper(model.MatchingTarget.ValueString())
+
+	var domains, ipAddresses, networkIDs, clientMACs []string
+	if isSet(model.Domains) {
+		diags.Append(model.Domains.ElementsAs(ctx, &domains, false)...)
+	}
+	if isSet(model.IpAddresses) {
+		diags.Append(model.IpAddresses.ElementsAs(ctx, &ipAddresses, false)...)
+	}
+	if isSet(model.NetworkIds) {
+		diags.Append(model.NetworkIds.ElementsAs(ctx, &networkIDs, false)...)
+	}
+	if isSet(model.ClientMacs) {
+		var d diag.Diagnostics
+		clientMACs, d = normalizedMacAddresses(ctx, model.ClientMacs)
+		diags.Append(d...)
+	}
+
+	json.Domains = nil
+	for _, domain := range domains {
+		json.Domains = append(json.Domains, trafficRouteDomain{Domain: domain})
+	}
+	json.IPAddresses = nil
+	for _, ipAddress := range ipAddresses {
+		json.IPAddresses = append(json.IPAddresses, trafficRouteIPAddress{IPOrSubnet: ipAddress})
+	}
+
+	json.TargetDevices = nil
+	for _, networkID := range networkIDs {
+		json.TargetDevices = append(json.TargetDevices, trafficRouteTargetDevice{Type: "NETWORK", NetworkID: networkID})
+	}
+	for _, clientMAC := range clientMACs {
+		json.TargetDevices = append(json.TargetDevices, trafficRouteTargetDevice{Type: "CLIENT", ClientMAC: clientMAC})
+	}
+	if len(json.TargetDevices) == 0 {
+		json.TargetDevices = []trafficRouteTargetDevice{{Type: "ALL_CLIENTS"}}
+	}
+
+	return diags
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_traffic_route"
+)
+
+func TestTrafficRouteResourceMapping(t *testing.T) {
+	ctx := context.Background()
+
+	domains, diags := types.SetValueFrom(ctx, types.StringType, []string{"example.com"})
+	assert.False(t, diags.HasError())
+	networks, diags := types.SetValueFrom(ctx, types.StringType, []string{"5f1a2b3c4d5e6f7a8b9c0d1e"})
+	assert.False(t, diags.HasError())
+
+	model := resource_traffic_route.TrafficRouteModel{
+		Name:           types.StringValue("Streaming over VPN"),
+		MatchingTarget: types.StringValue("domain"),
+		Domains:        domains,
+		NetworkIds:     networks,
+		InterfaceId:    types.StringValue("6a1b2c3d4e5f6a7b8c9d0e1f"),
+		KillSwitch:     types.BoolValue(true),
+	}
+
+	var body trafficRoute
+	diags = parseTrafficRouteResourceModel(ctx, model, &body)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "DOMAIN", body.MatchingTarget)
+	assert.Equal(t, []trafficRouteDomain{{Domain: "example.com"}}, body.Domains)
+	assert.Equal(t, []trafficRouteTargetDevice{{Type: "NETWORK", NetworkID: "5f1a2b3c4d5e6f7a8b9c0d1e"}}, body.TargetDevices)
+	assert.Equal(t, "6a1b2c3d4e5f6a7b8c9d0e1f", body.NetworkID)
+	assert.True(t, body.KillSwitchEnabled)
+
+	var state resource_traffic_route.TrafficRouteModel
+	diags = parseTrafficRouteResourceJson(ctx, body, &state)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, types.StringValue("domain"), state.MatchingTarget)
+	assert.Equal(t, domains, state.Domains)
+	assert.Equal(t, networks, state.NetworkIds)
+	assert.Equal(t, 0, len(state.ClientMacs.Elements()))
+
+	// Routes without targets apply to all clients.
+	model.NetworkIds = types.SetNull(types.StringType)
+	diags = parseTrafficRouteResourceModel(ctx, model, &body)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []trafficRouteTargetDevice{{Type: "ALL_CLIENTS"}}, body.TargetDevices)
+}
+
+func TestTrafficRoute_API(t *testing.T) {
+	ctx := context.Background()
+	var deleted bool
+	client := newAPITestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v2/api/site/default/trafficroutes":
+			var route map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&route))
+			route["_id"] = "route-1"
+			route["next_hop"] = ""
+			_ = json.NewEncoder(w).Encode(route)
+		case "GET /v2/api/site/default/trafficroutes":
+			_, _ = w.Write([]byte(`[{"_id":"route-1","description":"Streaming","network_id":"vpn-1"}]`))
+		case "DELETE /v2/api/site/default/trafficroutes/route-1":
+			deleted = true
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	route, err := client.createTrafficRoute(ctx, "default", &trafficRoute{Description: "Streaming", NetworkID: "vpn-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "route-1", route.ID)
+	assert.Equal(t, map[string]json.RawMessage{"next_hop": json.RawMessage(`""`)}, route.other)
+
+	route, err = client.getTrafficRoute(ctx, "default", "route-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "vpn-1", route.NetworkID)
+
+	assert.NoError(t, client.deleteTrafficRoute(ctx, "default", "route-1"))
+	assert.True(t, deleted)
+}
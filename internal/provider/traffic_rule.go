@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+)
+
+// trafficRule is a traffic rule of the v2 API. The settings trafficRule
+// doesn't model are kept in other and sent back unchanged, as an update
+// replaces the whole rule.
+type trafficRule struct {
+	ID             string                    `json:"_id,omitempty"`
+	SiteID         string                    `json:"site_id,omitempty"`
+	Description    string                    `json:"description"`
+	Enabled        bool                      `json:"enabled"`
+	Action         string                    `json:"action,omitempty"`
+	MatchingTarget string                    `json:"matching_target,omitempty"`
+	AppCategoryIDs []int                     `json:"app_category_ids"`
+	AppIDs         []int                     `json:"app_ids"`
+	Domains        []trafficRuleDomain       `json:"domains"`
+	IPAddresses    []trafficRuleIPAddress    `json:"ip_addresses"`
+	Regions        []string                  `json:"regions"`
+	TargetDevices  []trafficRuleTargetDevice `json:"target_devices"`
+	BandwidthLimit trafficRuleBandwidthLimit `json:"bandwidth_limit"`
+	Schedule       trafficRuleSchedule       `json:"schedule"`
+
+	// other holds the settings trafficRule doesn't model, by JSON key.
+	other map[string]json.RawMessage
+}
+
+type trafficRuleDomain struct {
+	Domain string `json:"domain"`
+}
+
+type trafficRuleIPAddress struct {
+	IPOrSubnet string `json:"ip_or_subnet"`
+}
+
+type trafficRuleTargetDevice struct {
+	Type          string `json:"type"`
+	ClientMAC     string `json:"client_mac,omitempty"`
+	NetworkID     string `json:"network_id,omitempty"`
+	ClientGroupID string `json:"client_group_id,omitempty"`
+}
+
+type trafficRuleBandwidthLimit struct {
+	Enabled           bool `json:"enabled"`
+	DownloadLimitKbps int  `json:"download_limit_kbps,omitempty"`
+	UploadLimitKbps   int  `json:"upload_limit_kbps,omitempty"`
+}
+
+type trafficRuleSchedule struct {
+	Mode           string   `json:"mode,omitempty"`
+	RepeatOnDays   []string `json:"repeat_on_days"`
+	TimeAllDay     bool     `json:"time_all_day"`
+	TimeRangeStart string   `json:"time_range_start,omitempty"`
+	TimeRangeEnd   string   `json:"time_range_end,omitempty"`
+	Date           string   `json:"date,omitempty"`
+}
+
+// trafficRuleFields has the fields of trafficRule without its JSON methods.
+type trafficRuleFields trafficRule
+
+// trafficRuleKeys are the JSON keys of the settings modeled by trafficRule.
+var trafficRuleKeys = jsonKeys(reflect.TypeOf(trafficRuleFields{}))
+
+func (r trafficRule) MarshalJSON() ([]byte, error) {
+	return marshalWithOther(trafficRuleFields(r), r.other)
+}
+
+func (r *trafficRule) UnmarshalJSON(b []byte) error {
+	other, err := unmarshalWithOther(b, (*trafficRuleFields)(r), trafficRuleKeys)
+	if err != nil {
+		return err
+	}
+	r.other = other
+
+	return nil
+}
+
+// dpiApp is an app of the catalog of apps recognized by the controller.
+type dpiApp struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	CategoryID   int    `json:"category_id"`
+	CategoryName string `json:"category_name"`
+}
+
+func trafficRulesPath(site, id string) string {
+	apiPath := "v2/api/site/" + url.PathEscape(site) + "/trafficrules"
+	if id != "" {
+		apiPath += "/" + url.PathEscape(id)
+	}
+	return apiPath
+}
+
+func (c *apiClient) getTrafficRule(ctx context.Context, site, id string) (*trafficRule, error) {
+	// The v2 API only lists the traffic rules of a site.
+	var rules []trafficRule
+	if err := c.do(ctx, http.MethodGet, trafficRulesPath(site, ""), nil, &rules); err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		if rule.ID == id {
+			return &rule, nil
+		}
+	}
+
+	return nil, fmt.Errorf("traffic rule %s not found", id)
+}
+
+func (c *apiClient) createTrafficRule(ctx context.Context, site string, rule *trafficRule) (*trafficRule, error) {
+	var created trafficRule
+	if err := c.do(ctx, http.MethodPost, trafficRulesPath(site, ""), rule, &created); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (c *apiClient) updateTrafficRule(ctx context.Context, site string, rule *trafficRule) (*trafficRule, error) {
+	var updated trafficRule
+	if err := c.do(ctx, http.MethodPut, trafficRulesPath(site, rule.ID), rule, &updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (c *apiClient) deleteTrafficRule(ctx context.Context, site, id string) error {
+	return c.do(ctx, http.MethodDelete, trafficRulesPath(site, id), nil, nil)
+}
+
+func (c *apiClient) listDPIApps(ctx context.Context, site string) ([]dpiApp, error) {
+	var apps []dpiApp
+	if err := c.do(ctx, http.MethodGet, "v2/api/site/"+url.PathEscape(site)+"/dpi/apps", nil, &apps); err != nil {
+		return nil, err
+	}
+
+	return apps, nil
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_traffic_rule"
+)
+
+var _ resource.ConfigValidator = trafficRuleConfigValidator{}
+
+// trafficRuleConfigValidator checks that the attributes of a
+// unifi_traffic_rule match its matching_target, action and schedule_mode.
+type trafficRuleConfigValidator struct{}
+
+func (v trafficRuleConfigValidator) Description(_ context.Context) string {
+	return "The matched apps, domains, addresses or regions must match matching_target, rate_limit rules require a " +
+		"bandwidth limit and the schedule attributes must match schedule_mode"
+}
+
+func (v trafficRuleConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v trafficRuleConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resource_traffic_rule.TrafficRuleModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTrafficRuleTarget(data)...)
+	resp.Diagnostics.Append(validateTrafficRuleLimit(data)...)
+	resp.Diagnostics.Append(validateTrafficRuleSchedule(data)...)
+}
+
+// validateTrafficRuleTarget checks that the attribute listing the traffic
+// matched by matching_target is set, and that the others are not.
+func validateTrafficRuleTarget(data resource_traffic_rule.TrafficRuleModel) diag.Diagnostics {
+	attributes := map[string]attr.Value{
+		"app_category_ids": data.AppCategoryIds,
+		"app_ids":          data.AppIds,
+		"domains":          data.Domains,
+		"ip_addresses":     data.IpAddresses,
+		"regions":          data.Regions,
+	}
+	targets := map[string]string{
+		"app_category_ids": "app_category",
+		"app_ids":          "app",
+		"domains":          "domain",
+		"ip_addresses":     "ip",
+		"regions":          "region",
+	}
+
+	return validateMatchingTarget(data.MatchingTarget, attributes, targets, "Traffic Rule")
+}
+
+// validateMatchingTarget checks that the attribute listing the traffic
+// matched by matchingTarget is set, and that the others are not. targets
+// maps the attributes to the matching_target they are used with.
+func validateMatchingTarget(matchingTarget types.String, attributes map[string]attr.Value, targets map[string]string, title string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if matchingTarget.IsUnknown() {
+		return diags
+	}
+
+	target := matchingTarget.ValueString()
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		switch {
+		case targets[name] == target && attributes[name].IsNull():
+			diags.AddAttributeError(
+				path.Root(name),
+				"Missing "+title+" Configuration",
+				fmt.Sprintf("The %s attribute is required when matching_target is %q.", name, target),
+			)
+		case targets[name] != target && isSet(attributes[name]):
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid "+title+" Configuration",
+				fmt.Sprintf("The %s attribute can only be set when matching_target is %q.", name, targets[name]),
+			)
+		}
+	}
+
+	return diags
+}
+
+// validateTrafficRuleLimit checks that rate_limit rules have a bandwidth
+// limit, and that other rules don't.
+func validateTrafficRuleLimit(data resource_traffic_rule.TrafficRuleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Action.IsUnknown() {
+		return diags
+	}
+
+	if data.Action.ValueString() == "rate_limit" {
+		if data.DownloadLimit.IsNull() && data.UploadLimit.IsNull() {
+			diags.AddAttributeError(
+				path.Root("download_limit"),
+				"Missing Traffic Rule Configuration",
+				"At least one of download_limit and upload_limit is required when action is \"rate_limit\".",
+			)
+		}
+
+		return diags
+	}
+
+	attributes := map[string]attr.Value{
+		"download_limit": data.DownloadLimit,
+		"upload_limit":   data.UploadLimit,
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		if isSet(attributes[name]) {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid Traffic Rule Configuration",
+				fmt.Sprintf("The %s attribute can only be set when action is \"rate_limit\".", name),
+			)
+		}
+	}
+
+	return diags
+}
+
+// validateTrafficRuleSchedule checks the schedule attributes required by
+// schedule_mode, and that the time range has both ends.
+func validateTrafficRuleSchedule(data resource_traffic_rule.TrafficRuleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.ScheduleStartTime.IsNull() != data.ScheduleEndTime.IsNull() {
+		diags.AddAttributeError(
+			path.Root("schedule_end_time"),
+			"Invalid Traffic Rule Configuration",
+			"The schedule_start_time and schedule_end_time attributes must be set together.",
+		)
+	}
+
+	if data.ScheduleMode.IsUnknown() {
+		return diags
+	}
+
+	// A null schedule_mode is the default, always.
+	mode := data.ScheduleMode.ValueString()
+	if data.ScheduleMode.IsNull() {
+		mode = "always"
+	}
+
+	if mode == "always" {
+		attributes := map[string]attr.Value{
+			"schedule_date":       data.ScheduleDate,
+			"schedule_days":       data.ScheduleDays,
+			"schedule_end_time":   data.ScheduleEndTime,
+			"schedule_start_time": data.ScheduleStartTime,
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(attributes)) {
+			if isSet(attributes[name]) {
+				diags.AddAttributeError(
+					path.Root(name),
+					"Invalid Traffic Rule Configuration",
+					fmt.Sprintf("The %s attribute can't be set when schedule_mode is \"always\".", name),
+				)
+			}
+		}
+
+		return diags
+	}
+
+	switch {
+	case mode == "every_week" && data.ScheduleDays.IsNull():
+		diags.AddAttributeError(
+			path.Root("schedule_days"),
+			"Missing Traffic Rule Configuration",
+			"The schedule_days attribute is required when schedule_mode is \"every_week\".",
+		)
+	case mode != "every_week" && isSet(data.ScheduleDays):
+		diags.AddAttributeError(
+			path.Root("schedule_days"),
+			"Invalid Traffic Rule Configuration",
+			"The schedule_days attribute can only be set when schedule_mode is \"every_week\".",
+		)
+	}
+
+	switch {
+	case mode == "one_time" && data.ScheduleDate.IsNull():
+		diags.AddAttributeError(
+			path.Root("schedule_date"),
+			"Missing Traffic Rule Configuration",
+			"The schedule_date attribute is required when schedule_mode is \"one_time\".",
+		)
+	case mode != "one_time" && isSet(data.ScheduleDate):
+		diags.AddAttributeError(
+			path.Root("schedule_date"),
+			"Invalid Traffic Rule Configuration",
+			"The schedule_date attribute can only be set when schedule_mode is \"one_time\".",
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_traffic_rule"
+)
+
+func TestValidateTrafficRuleTarget(t *testing.T) {
+	ctx := context.Background()
+
+	appIds, diags := types.SetValueFrom(ctx, types.Int64Type, []int{589885})
+	assert.False(t, diags.HasError())
+	domains, diags := types.SetValueFrom(ctx, types.StringType, []string{"example.com"})
+	assert.False(t, diags.HasError())
+
+	model := resource_traffic_rule.TrafficRuleModel{
+		MatchingTarget: types.StringValue("app"),
+		AppIds:         appIds,
+	}
+	assert.False(t, validateTrafficRuleTarget(model).HasError())
+
+	model.Domains = domains
+	assert.Equal(t, 1, validateTrafficRuleTarget(model).ErrorsCount())
+
+	model.MatchingTarget = types.StringValue("internet")
+	assert.Equal(t, 2, validateTrafficRuleTarget(model).ErrorsCount())
+
+	model.MatchingTarget = types.StringValue("region")
+	assert.Equal(t, 3, validateTrafficRuleTarget(model).ErrorsCount())
+}
+
+func TestValidateTrafficRuleLimit(t *testing.T) {
+	model := resource_traffic_rule.TrafficRuleModel{
+		Action: types.StringValue("rate_limit"),
+	}
+	assert.Equal(t, 1, validateTrafficRuleLimit(model).ErrorsCount())
+
+	model.UploadLimit = types.Int64Value(1000)
+	assert.False(t, validateTrafficRuleLimit(model).HasError())
+
+	model.Action = types.StringValue("block")
+	assert.Equal(t, 1, validateTrafficRuleLimit(model).ErrorsCount())
+}
+
+func TestValidateTrafficRuleSchedule(t *testing.T) {
+	days, diags := types.SetValueFrom(context.Background(), types.StringType, []string{"mon", "fri"})
+	assert.False(t, diags.HasError())
+
+	model := resource_traffic_rule.TrafficRuleModel{
+		ScheduleMode:      types.StringValue("every_week"),
+		ScheduleDays:      days,
+		ScheduleStartTime: types.StringValue("08:00"),
+		ScheduleEndTime:   types.StringValue("15:30"),
+	}
+	assert.False(t, validateTrafficRuleSchedule(model).HasError())
+
+	model.ScheduleEndTime = types.StringNull()
+	assert.Equal(t, 1, validateTrafficRuleSchedule(model).ErrorsCount())
+
+	model.ScheduleMode = types.StringValue("one_time")
+	assert.Equal(t, 3, validateTrafficRuleSchedule(model).ErrorsCount())
+
+	model.ScheduleMode = types.StringNull()
+	assert.Equal(t, 3, validateTrafficRuleSchedule(model).ErrorsCount())
+}
@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_traffic_rule"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &trafficRuleResource{}
+	_ resource.ResourceWithConfigure        = &trafficRuleResource{}
+	_ resource.ResourceWithConfigValidators = &trafficRuleResource{}
+	_ resource.ResourceWithImportState      = &trafficRuleResource{}
+	_ resource.ResourceWithModifyPlan       = &trafficRuleResource{}
+)
+
+func NewTrafficRuleResource() resource.Resource {
+	return &trafficRuleResource{}
+}
+
+type trafficRuleResource struct {
+	client *unifiClient
+}
+
+func (r *trafficRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_traffic_rule"
+}
+
+func (r *trafficRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_traffic_rule.TrafficRuleResourceSchema(ctx)
+}
+
+func (r *trafficRuleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		trafficRuleConfigValidator{},
+	}
+}
+
+func (r *trafficRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nill check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *trafficRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Traffic rules are only available through the v2 API, check the controller
+	// supports it before creating one.
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var site types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("site"), &site)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(requireControllerCapability(ctx, r.client, site.ValueString(), capabilityV2Api, path.Empty())...)
+}
+
+func (r *trafficRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *trafficRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create Traffic Rule")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_traffic_rule.TrafficRuleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var body trafficRule
+	resp.Diagnostics.Append(parseTrafficRuleResourceModel(ctx, data, &body)...)
+	rule, err := r.client.api.createTrafficRule(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Traffic Rule",
+			"Could not create Traffic Rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseTrafficRuleResourceJson(ctx, *rule, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *rule)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *trafficRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_traffic_rule.TrafficRuleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed Traffic Rule value from Unifi
+	rule, err := r.client.api.getTrafficRule(ctx, data.Site.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Traffic Rule",
+			"Could not read Traffic Rule ID "+data.Id.ValueString()+"; "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseTrafficRuleResourceJson(ctx, *rule, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *rule)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *trafficRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update Traffic Rule")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_traffic_rule.TrafficRuleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := readForUpdate(ctx, r.client, req.Private, "Traffic Rule", func() (*trafficRule, error) {
+		return r.client.api.getTrafficRule(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	resp.Diagnostics.Append(parseTrafficRuleResourceModel(ctx, data, &body)...)
+	rule, err := r.client.api.updateTrafficRule(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Traffic Rule",
+			"Could not update Traffic Rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseTrafficRuleResourceJson(ctx, *rule, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *rule)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *trafficRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete Traffic Rule")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_traffic_rule.TrafficRuleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing Traffic Rule
+	err := r.client.api.deleteTrafficRule(ctx, data.Site.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Traffic Rule",
+			"Could not delete Traffic Rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func parseTrafficRuleResourceJson(ctx context.Context, json trafficRule, model *resource_traffic_rule.TrafficRuleModel) diag.Diagnostics {
+	diags := mapTrafficRuleResourceJson(ctx, json, model)
+
+	switch {
+	case json.Action == "BLOCK":
+		model.Action = types.StringValue("block")
+	case json.BandwidthLimit.Enabled:
+		model.Action = types.StringValue("rate_limit")
+	default:
+		model.Action = types.StringValue("allow")
+	}
+
+	model.MatchingTarget = types.StringValue(strings.ToLower(json.MatchingTarget))
+	model.ScheduleMode = types.StringValue(trafficRuleScheduleModes[json.Schedule.Mode])
+
+	domains := make([]string, 0, len(json.Domains))
+	for _, domain := range json.Domains {
+		domains = append(domains, domain.Domain)
+	}
+	ipAddresses := make([]string, 0, len(json.IPAddresses))
+	for _, ipAddress := range json.IPAddresses {
+		ipAddresses = append(ipAddresses, ipAddress.IPOrSubnet)
+	}
+
+	networkIDs, clientMACs, clientGroupIDs := []string{}, []string{}, []string{}
+	for _, target := range json.TargetDevices {
+		switch target.Type {
+		case "NETWORK":
+			networkIDs = append(networkIDs, target.NetworkID)
+		case "CLIENT":
+			clientMACs = append(clientMACs, target.ClientMAC)
+		case "GROUP":
+			clientGroupIDs = append(clientGroupIDs, target.ClientGroupID)
+		}
+	}
+
+	var d diag.Diagnostics
+	model.Domains, d = types.SetValueFrom(ctx, types.StringType, domains)
+	diags.Append(d...)
+	model.IpAddresses, d = types.SetValueFrom(ctx, types.StringType, ipAddresses)
+	diags.Append(d...)
+	model.NetworkIds, d = types.SetValueFrom(ctx, types.StringType, networkIDs)
+	diags.Append(d...)
+	model.ClientMacs, d = types.SetValueFrom(ctx, customtypes.MacAddressType{}, clientMACs)
+	diags.Append(d...)
+	model.ClientGroupIds, d = types.SetValueFrom(ctx, types.StringType, clientGroupIDs)
+	diags.Append(d...)
+
+	return diags
+}
+
+func parseTrafficRuleResourceModel(ctx context.Context, model resource_traffic_rule.TrafficRuleModel, json *trafficRule) diag.Diagnostics {
+	diags := mapTrafficRuleResourceModel(ctx, model, json)
+
+	json.Action = "ALLOW"
+	if model.Action.ValueString() == "block" {
+		json.Action = "BLOCK"
+	}
+	json.BandwidthLimit.Enabled = model.Action.ValueString() == "rate_limit"
+
+	json.MatchingTarget = strings.ToUpper(model.MatchingTarget.ValueString())
+
+	for mode, name := range trafficRuleScheduleModes {
+		if name == model.ScheduleMode.ValueString() {
+			json.Schedule.Mode = mode
+		}
+	}
+	json.Schedule.TimeAllDay = json.Schedule.TimeRangeStart == "" && json.Schedule.TimeRangeEnd == ""
+
+	var domains, ipAddresses, networkIDs, clientMACs, clientGroupIDs []string
+	if isSet(model.Domains) {
+		diags.Append(model.Domains.ElementsAs(ctx, &domains, false)...)
+	}
+	if isSet(model.IpAddresses) {
+		diags.Append(model.IpAddresses.ElementsAs(ctx, &ipAddresses, false)...)
+	}
+	if isSet(model.NetworkIds) {
+		diags.Append(model.NetworkIds.ElementsAs(ctx, &networkIDs, false)...)
+	}
+	if isSet(model.ClientMacs) {
+		var d diag.Diagnostics
+		clientMACs, d = normalizedMacAddresses(ctx, model.ClientMacs)
+		diags.Append(d...)
+	}
+	if isSet(model.ClientGroupIds) {
+		diags.Append(model.ClientGroupIds.ElementsAs(ctx, &clientGroupIDs, false)...)
+	}
+
+	json.Domains = nil
+	for _, domain := range domains {
+		json.Domains = append(json.Domains, trafficRuleDomain{Domain: domain})
+	}
+	json.IPAddresses = nil
+	for _, ipAddress := range ipAddresses {
+		json.IPAddresses = append(json.IPAddresses, trafficRuleIPAddress{IPOrSubnet: ipAddress})
+	}
+
+	json.TargetDevices = nil
+	for _, networkID := range networkIDs {
+		json.TargetDevices = append(json.TargetDevices, trafficRuleTargetDevice{Type: "NETWORK", NetworkID: networkID})
+	}
+	for _, clientMAC := range clientMACs {
+		json.TargetDevices = append(json.TargetDevices, trafficRuleTargetDevice{Type: "CLIENT", ClientMAC: clientMAC})
+	}
+	for _, clientGroupID := range clientGroupIDs {
+		json.TargetDevices = append(json.TargetDevices, trafficRuleTargetDevice{Type: "GROUP", ClientGroupID: clientGroupID})
+	}
+	if len(json.TargetDevices) == 0 {
+		json.TargetDevices = []trafficRuleTargetDevice{{Type: "ALL_CLIENTS"}}
+	}
+
+	return diags
+}
+
+// trafficRuleScheduleModes maps the schedule modes of the controller to the
+// schedule_mode of a unifi_traffic_rule.
+var trafficRuleScheduleModes = map[string]string{
+	"ALWAYS":        "always",
+	"EVERY_DAY":     "every_day",
+	"EVERY_WEEK":    "every_week",
+	"ONE_TIME_ONLY": "one_time",
+}
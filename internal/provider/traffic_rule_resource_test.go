@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_traffic_rule"
+)
+
+func TestTrafficRuleResourceMapping(t *testing.T) {
+	ctx := context.Background()
+
+	categories, diags := types.SetValueFrom(ctx, types.Int64Type, []int{8})
+	assert.False(t, diags.HasError())
+	macs, diags := types.SetValueFrom(ctx, customtypes.MacAddressType{}, []string{"00-11-22-AA-BB-CC"})
+	assert.False(t, diags.HasError())
+
+	model := resource_traffic_rule.TrafficRuleModel{
+		Name:           types.StringValue("Block games"),
+		Action:         types.StringValue("block"),
+		MatchingTarget: types.StringValue("app_category"),
+		AppCategoryIds: categories,
+		ClientMacs:     macs,
+		ScheduleMode:   types.StringValue("every_day"),
+	}
+
+	var body trafficRule
+	diags = parseTrafficRuleResourceModel(ctx, model, &body)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "BLOCK", body.Action)
+	assert.Equal(t, "APP_CATEGORY", body.MatchingTarget)
+	assert.Equal(t, []int{8}, body.AppCategoryIDs)
+	assert.Equal(t, "EVERY_DAY", body.Schedule.Mode)
+	assert.True(t, body.Schedule.TimeAllDay)
+	assert.Equal(t, []trafficRuleTargetDevice{{Type: "CLIENT", ClientMAC: "00:11:22:aa:bb:cc"}}, body.TargetDevices)
+
+	var state resource_traffic_rule.TrafficRuleModel
+	diags = parseTrafficRuleResourceJson(ctx, body, &state)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, types.StringValue("block"), state.Action)
+	assert.Equal(t, types.StringValue("app_category"), state.MatchingTarget)
+	assert.Equal(t, types.StringValue("every_day"), state.ScheduleMode)
+	assert.Equal(t, categories, state.AppCategoryIds)
+	assert.Equal(t, 0, len(state.NetworkIds.Elements()))
+
+	// Rules without targets apply to all clients.
+	model.ClientMacs = types.SetNull(customtypes.MacAddressType{})
+	model.Action = types.StringValue("rate_limit")
+	model.DownloadLimit = types.Int64Value(2000)
+	diags = parseTrafficRuleResourceModel(ctx, model, &body)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []trafficRuleTargetDevice{{Type: "ALL_CLIENTS"}}, body.TargetDevices)
+	assert.Equal(t, "ALLOW", body.Action)
+	assert.True(t, body.BandwidthLimit.Enabled)
+
+	diags = parseTrafficRuleResourceJson(ctx, body, &state)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, types.StringValue("rate_limit"), state.Action)
+	assert.Equal(t, types.Int64Value(2000), state.DownloadLimit)
+}
+
+func TestTrafficRuleResourceMapping_Schedule(t *testing.T) {
+	ctx := context.Background()
+
+	appIds, diags := types.SetValueFrom(ctx, types.Int64Type, []int{589885})
+	assert.False(t, diags.HasError())
+	scheduleDays, diags := types.SetValueFrom(ctx, types.StringType, []string{"mon", "fri"})
+	assert.False(t, diags.HasError())
+	clientMacs, diags := types.SetValueFrom(ctx, customtypes.MacAddressType{}, []string{"AA-BB-CC-DD-EE-FF"})
+	assert.False(t, diags.HasError())
+
+	model := resource_traffic_rule.TrafficRuleModel{
+		Name:              types.StringValue("Limit streaming"),
+		Action:            types.StringValue("rate_limit"),
+		MatchingTarget:    types.StringValue("app"),
+		AppIds:            appIds,
+		DownloadLimit:     types.Int64Value(10000),
+		ClientMacs:        clientMacs,
+		ScheduleMode:      types.StringValue("every_week"),
+		ScheduleDays:      scheduleDays,
+		ScheduleStartTime: types.StringValue("18:00"),
+		ScheduleEndTime:   types.StringValue("23:00"),
+	}
+
+	var body trafficRule
+	diags = parseTrafficRuleResourceModel(ctx, model, &body)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "ALLOW", body.Action)
+	assert.True(t, body.BandwidthLimit.Enabled)
+	assert.Equal(t, 10000, body.BandwidthLimit.DownloadLimitKbps)
+	assert.Equal(t, "APP", body.MatchingTarget)
+	assert.Equal(t, []int{589885}, body.AppIDs)
+	assert.Equal(t, []trafficRuleTargetDevice{{Type: "CLIENT", ClientMAC: "aa:bb:cc:dd:ee:ff"}}, body.TargetDevices)
+	assert.Equal(t, "EVERY_WEEK", body.Schedule.Mode)
+	days := slices.Sorted(slices.Values(body.Schedule.RepeatOnDays))
+	assert.Equal(t, []string{"fri", "mon"}, days)
+	assert.Equal(t, "18:00", body.Schedule.TimeRangeStart)
+	assert.Equal(t, "23:00", body.Schedule.TimeRangeEnd)
+	assert.False(t, body.Schedule.TimeAllDay)
+
+	var state resource_traffic_rule.TrafficRuleModel
+	diags = parseTrafficRuleResourceJson(ctx, body, &state)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, types.StringValue("rate_limit"), state.Action)
+	assert.Equal(t, types.StringValue("app"), state.MatchingTarget)
+	assert.Equal(t, appIds, state.AppIds)
+	assert.Equal(t, types.StringValue("every_week"), state.ScheduleMode)
+	assert.Equal(t, scheduleDays, state.ScheduleDays)
+	assert.Equal(t, types.StringValue("18:00"), state.ScheduleStartTime)
+	assert.Equal(t, types.StringValue("23:00"), state.ScheduleEndTime)
+	assert.Equal(t, 1, len(state.ClientMacs.Elements()))
+	assert.Equal(t, 0, len(state.NetworkIds.Elements()))
+
+	// A one time rule without a time range lasts the whole day.
+	model.ScheduleMode = types.StringValue("one_time")
+	model.ScheduleDate = types.StringValue("2025-12-24")
+	model.ScheduleStartTime = types.StringValue("")
+	model.ScheduleEndTime = types.StringValue("")
+	diags = parseTrafficRuleResourceModel(ctx, model, &body)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "ONE_TIME_ONLY", body.Schedule.Mode)
+	assert.Equal(t, "2025-12-24", body.Schedule.Date)
+	assert.True(t, body.Schedule.TimeAllDay)
+
+	diags = parseTrafficRuleResourceJson(ctx, body, &state)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, types.StringValue("one_time"), state.ScheduleMode)
+	assert.Equal(t, types.StringValue("2025-12-24"), state.ScheduleDate)
+}
+
+func TestTrafficRule_API(t *testing.T) {
+	ctx := context.Background()
+	client := newAPITestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/api/site/default/trafficrules":
+			_, _ = w.Write([]byte(`[{"_id":"rule-1","description":"Block ads","action":"BLOCK","ip_ranges":[{"ip_start":"10.0.0.1","ip_stop":"10.0.0.9"}]}]`))
+		case "PUT /v2/api/site/default/trafficrules/rule-1":
+			var body map[string]json.RawMessage
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, `"Block trackers"`, string(body["description"]))
+			assert.Equal(t, `[{"ip_start":"10.0.0.1","ip_stop":"10.0.0.9"}]`, string(body["ip_ranges"]))
+			_ = json.NewEncoder(w).Encode(body)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	rule, err := client.getTrafficRule(ctx, "default", "rule-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Block ads", rule.Description)
+
+	_, err = client.getTrafficRule(ctx, "default", "rule-2")
+	assert.ErrorContains(t, err, "traffic rule rule-2 not found")
+
+	// Settings the provider doesn't manage, e.g. IP ranges, are sent back.
+	body := *rule
+	body.Description = "Block trackers"
+	updated, err := client.updateTrafficRule(ctx, "default", &body)
+	assert.NoError(t, err)
+	assert.Equal(t, "Block trackers", updated.Description)
+	assert.Equal(t, rule.other, updated.other)
+}
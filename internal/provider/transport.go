@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const defaultConnectTimeout = 30 * time.Second
+
+// transportOptions holds the provider configuration for the HTTP transport
+// used to talk to the Unifi Controller.
+type transportOptions struct {
+	tlsConfig      *tls.Config
+	proxyURL       *url.URL
+	connectTimeout time.Duration
+	requestTimeout time.Duration
+	headers        map[string]string
+}
+
+// newHTTPTransport returns the base transport for requests to the Unifi
+// Controller. When no proxy is configured the standard HTTP_PROXY,
+// HTTPS_PROXY and NO_PROXY environment variables are honoured.
+func newHTTPTransport(opts transportOptions) http.RoundTripper {
+	var transport http.RoundTripper = newBaseTransport(opts)
+
+	if len(opts.headers) > 0 {
+		transport = &headerTransport{
+			next:    transport,
+			headers: opts.headers,
+		}
+	}
+
+	if opts.requestTimeout > 0 {
+		transport = &timeoutTransport{
+			next:    transport,
+			timeout: opts.requestTimeout,
+		}
+	}
+
+	return transport
+}
+
+func newBaseTransport(opts transportOptions) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = opts.tlsConfig
+	transport.DialContext = (&net.Dialer{
+		Timeout:   opts.connectTimeout,
+		KeepAlive: 30 * time.Second,
+	}).DialContext
+
+	if opts.proxyURL != nil {
+		transport.Proxy = http.ProxyURL(opts.proxyURL)
+	}
+
+	return transport
+}
+
+var _ http.RoundTripper = &headerTransport{}
+
+// headerTransport is an http.RoundTripper that adds a fixed set of headers to
+// every request, e.g. for a reverse proxy in front of the Unifi Controller.
+type headerTransport struct {
+	next    http.RoundTripper
+	headers map[string]string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the original request.
+	req = req.Clone(req.Context())
+	for name, value := range t.headers {
+		req.Header.Set(name, value)
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+var _ http.RoundTripper = &timeoutTransport{}
+
+// timeoutTransport is an http.RoundTripper that bounds each request, from
+// sending it to reading the whole response body. The SDK creates its own
+// http.Client, so the timeout can't be set there.
+type timeoutTransport struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The deadline still applies while the body is read, and is released
+	// once it's closed.
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+
+	return err
+}
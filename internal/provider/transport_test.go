@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHTTPTransport_Headers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("X-Proxy-Auth"))
+		assert.Equal(t, "api-key", r.Header.Get("X-Api-Key"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: newHTTPTransport(transportOptions{
+			connectTimeout: time.Second,
+			headers:        map[string]string{"X-Proxy-Auth": "secret"},
+		}),
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req.Header.Set("X-Api-Key", "api-key")
+	resp, err := client.Do(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, req.Header.Get("X-Proxy-Auth"))
+}
+
+func TestNewHTTPTransport_Proxy(t *testing.T) {
+	proxyURL, _ := url.Parse("http://proxy.example.com:3128")
+
+	transport := newHTTPTransport(transportOptions{
+		proxyURL:       proxyURL,
+		connectTimeout: time.Second,
+	}).(*http.Transport)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://unifi.example.com", nil)
+	got, err := transport.Proxy(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, proxyURL.String(), got.String())
+}
+
+func TestNewHTTPTransport_RequestTimeout(t *testing.T) {
+	// The headers are sent right away, the body only after the timeout.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+
+		select {
+		case <-time.After(5 * time.Second):
+		case <-r.Context().Done():
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: newHTTPTransport(transportOptions{
+			connectTimeout: time.Second,
+			requestTimeout: 100 * time.Millisecond,
+		}),
+	}
+
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	if assert.NoError(t, err) {
+		_, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+	}
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, time.Since(start) < 5*time.Second)
+}
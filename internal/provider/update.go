@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+// readForUpdate reads the object an Update starts from with get. Updates
+// overlay the planned attributes on the current object, so the fields not
+// managed by Terraform are sent back unchanged. Resources recording a
+// fingerprint pass their private state, and the update is refused when the
+// object was modified outside Terraform since plan, see checkFingerprint.
+// name describes the object, e.g. "Network".
+func readForUpdate[T any](ctx context.Context, client unifi.Client, private privateStateGetter, name string, get func() (*T, error)) (*T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	current, err := get()
+	if err != nil {
+		diags.AddError(
+			"Error updating "+name,
+			"Could not read the current "+name+", unexpected error: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	if private != nil {
+		diags.Append(checkFingerprint(ctx, client, private, *current, name)...)
+	}
+
+	return current, diags
+}
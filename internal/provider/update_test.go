@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/unifi-go/unifi"
+)
+
+func TestReadForUpdate(t *testing.T) {
+	ctx := context.Background()
+	client := &unifiClient{}
+	current := unifi.Network{ID: "net-123", Name: "LAN (edited in UI)"}
+	get := func() (*unifi.Network, error) {
+		return &current, nil
+	}
+
+	private := testPrivateState{}
+	assert.False(t, setFingerprint(ctx, private, unifi.Network{ID: "net-123", Name: "LAN"}).HasError())
+
+	// Without private state, the object isn't checked.
+	network, diags := readForUpdate(ctx, client, nil, "Network", get)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, current, *network)
+
+	_, diags = readForUpdate(ctx, client, private, "Network", get)
+	assert.True(t, diags.HasError())
+
+	_, diags = readForUpdate(ctx, client, nil, "Network", func() (*unifi.Network, error) {
+		return nil, errors.New("not found")
+	})
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Error updating Network", diags[0].Summary())
+}
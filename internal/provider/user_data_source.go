@@ -10,7 +10,6 @@ import (
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_user"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -73,23 +72,8 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	parseUserDataSourceJson(*user, &data)
+	mapUserDataSourceJson(*user, &data)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-func parseUserDataSourceJson(json unifi.User, model *datasource_user.UserModel) {
-	model.Id = types.StringValue(json.ID)
-	model.Blocked = types.BoolValue(json.Blocked)
-	model.DevIdOverride = types.Int64Value(int64(json.DevIdOverride))
-	model.FixedIp = types.StringValue(json.FixedIP)
-	model.Hostname = types.StringValue(json.Hostname)
-	model.Ip = types.StringValue(json.IP)
-	model.LocalDnsRecord = types.StringValue(json.LocalDNSRecord)
-	model.Mac = types.StringValue(json.MAC)
-	model.Name = types.StringValue(json.Name)
-	model.NetworkId = types.StringValue(json.NetworkID)
-	model.Note = types.StringValue(json.Note)
-	model.UserGroupId = types.StringValue(json.UserGroupID)
-}
@@ -10,7 +10,6 @@ import (
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_user_group"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -87,15 +86,8 @@ func (d *userGroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	parseUserGroupDataSourceJson(*userGroup, &data)
+	mapUserGroupDataSourceJson(*userGroup, &data)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
-
-func parseUserGroupDataSourceJson(json unifi.UserGroup, model *datasource_user_group.UserGroupModel) {
-	model.Id = types.StringValue(json.ID)
-	model.Name = types.StringValue(json.Name)
-	model.QosRateMaxDown = types.Int64Value(int64(json.QOSRateMaxDown))
-	model.QosRateMaxUp = types.Int64Value(int64(json.QOSRateMaxUp))
-}
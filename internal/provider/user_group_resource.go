@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/zoullx/terraform-provider-unifi/internal/resource_user_group"
@@ -61,9 +60,8 @@ func (r *userGroupResource) Configure(ctx context.Context, req resource.Configur
 }
 
 func (r *userGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
 			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
@@ -71,11 +69,16 @@ func (r *userGroupResource) ImportState(ctx context.Context, req resource.Import
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (r *userGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create User Group")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_user_group.UserGroupModel
 
 	// Read Terraform plan data into the model
@@ -85,7 +88,7 @@ func (r *userGroupResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	var body unifi.UserGroup
-	parseUserGroupResourceModel(data, &body)
+	mapUserGroupResourceModel(data, &body)
 	userGroup, err := r.client.CreateUserGroup(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -95,7 +98,7 @@ func (r *userGroupResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	parseUserGroupResourceJson(*userGroup, &data)
+	mapUserGroupResourceJson(*userGroup, &data)
 
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -122,13 +125,18 @@ func (r *userGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	parseUserGroupResourceJson(*userGroup, &data)
+	mapUserGroupResourceJson(*userGroup, &data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *userGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update User Group")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_user_group.UserGroupModel
 
 	// Read Terraform plan data into the model
@@ -137,8 +145,16 @@ func (r *userGroupResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	var body unifi.UserGroup
-	parseUserGroupResourceModel(data, &body)
+	current, diags := readForUpdate(ctx, r.client, nil, "User Group", func() (*unifi.UserGroup, error) {
+		return r.client.GetUserGroup(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	mapUserGroupResourceModel(data, &body)
 	userGroup, err := r.client.UpdateUserGroup(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -148,7 +164,7 @@ func (r *userGroupResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	parseUserGroupResourceJson(*userGroup, &data)
+	mapUserGroupResourceJson(*userGroup, &data)
 
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -157,6 +173,11 @@ func (r *userGroupResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *userGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete User Group")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_user_group.UserGroupModel
 
 	// Read Terraform prior state data into the model
@@ -175,17 +196,3 @@ func (r *userGroupResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 }
-
-func parseUserGroupResourceJson(json unifi.UserGroup, model *resource_user_group.UserGroupModel) {
-	model.Id = types.StringValue(json.ID)
-	model.Name = types.StringValue(json.Name)
-	model.QosRateMaxDown = types.Int64Value(int64(json.QOSRateMaxDown))
-	model.QosRateMaxUp = types.Int64Value(int64(json.QOSRateMaxUp))
-}
-
-func parseUserGroupResourceModel(model resource_user_group.UserGroupModel, json *unifi.UserGroup) {
-	json.ID = model.Id.ValueString()
-	json.Name = model.Name.ValueString()
-	json.QOSRateMaxDown = int(model.QosRateMaxDown.ValueInt64())
-	json.QOSRateMaxUp = int(model.QosRateMaxUp.ValueInt64())
-}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,7 +83,7 @@ func (d *userGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 }
 
 func parseUserGroupsDataSourceJson(ctx context.Context, json []unifi.UserGroup, model *datasource_user_groups.UserGroupsModel) diag.Diagnostics {
-	userGroupList, diags := types.ListValueFrom(ctx, datasource_user_groups.UserGroupsValue{}.Type(ctx), json)
+	userGroupList, diags := objectListValue(ctx, datasource_user_groups.UserGroupsValue{}.Type(ctx), json, mapUserGroupsDataSourceJson)
 	if diags.HasError() {
 		return diags
 	}
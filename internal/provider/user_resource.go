@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/zoullx/terraform-provider-unifi/internal/resource_user"
@@ -61,9 +60,8 @@ func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequ
 }
 
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
 			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
@@ -71,11 +69,16 @@ func (r *userResource) ImportState(ctx context.Context, req resource.ImportState
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create User")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_user.UserModel
 
 	// Read Terraform plan data into the model
@@ -85,7 +88,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	var body unifi.User
-	parseUserResourceModel(data, &body)
+	mapUserResourceModel(data, &body)
 	user, err := r.client.CreateUser(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -95,7 +98,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	parseUserResourceJson(*user, &data)
+	mapUserResourceJson(*user, &data)
 
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -122,13 +125,18 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	parseUserResourceJson(*user, &data)
+	mapUserResourceJson(*user, &data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update User")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_user.UserModel
 
 	// Read Terraform plan data into the model
@@ -137,8 +145,16 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	var body unifi.User
-	parseUserResourceModel(data, &body)
+	current, diags := readForUpdate(ctx, r.client, nil, "User", func() (*unifi.User, error) {
+		return r.client.GetUser(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	mapUserResourceModel(data, &body)
 	user, err := r.client.UpdateUser(ctx, data.Site.ValueString(), &body)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -148,7 +164,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	parseUserResourceJson(*user, &data)
+	mapUserResourceJson(*user, &data)
 
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -157,6 +173,11 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 }
 
 func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete User")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_user.UserModel
 
 	// Read Terraform prior state data into the model
@@ -175,33 +196,3 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 }
-
-func parseUserResourceJson(json unifi.User, model *resource_user.UserModel) {
-	model.Id = types.StringValue(json.ID)
-	model.Blocked = types.BoolValue(json.Blocked)
-	model.DevIdOverride = types.Int64Value(int64(json.DevIdOverride))
-	model.FixedIp = types.StringValue(json.FixedIP)
-	model.Hostname = types.StringValue(json.Hostname)
-	model.Ip = types.StringValue(json.IP)
-	model.LocalDnsRecord = types.StringValue(json.LocalDNSRecord)
-	model.Mac = types.StringValue(json.MAC)
-	model.Name = types.StringValue(json.Name)
-	model.NetworkId = types.StringValue(json.NetworkID)
-	model.Note = types.StringValue(json.Note)
-	model.UserGroupId = types.StringValue(json.UserGroupID)
-}
-
-func parseUserResourceModel(model resource_user.UserModel, json *unifi.User) {
-	json.ID = model.Id.ValueString()
-	json.Blocked = model.Blocked.ValueBool()
-	json.DevIdOverride = int(model.DevIdOverride.ValueInt64())
-	json.FixedIP = model.FixedIp.ValueString()
-	json.Hostname = model.Hostname.ValueString()
-	json.IP = model.Ip.ValueString()
-	json.LocalDNSRecord = model.LocalDnsRecord.ValueString()
-	json.MAC = model.Mac.ValueString()
-	json.Name = model.Name.ValueString()
-	json.NetworkID = model.NetworkId.ValueString()
-	json.Note = model.Note.ValueString()
-	json.UserGroupID = model.UserGroupId.ValueString()
-}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
@@ -84,7 +83,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 }
 
 func parseUsersDataSourceJson(ctx context.Context, json []unifi.User, model *datasource_users.UsersModel) diag.Diagnostics {
-	userList, diags := types.ListValueFrom(ctx, datasource_users.UsersValue{}.Type(ctx), json)
+	userList, diags := objectListValue(ctx, datasource_users.UsersValue{}.Type(ctx), json, mapUsersDataSourceJson)
 	if diags.HasError() {
 		return diags
 	}
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_vpn_openvpn_client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &vpnOpenvpnClientResource{}
+	_ resource.ResourceWithConfigure   = &vpnOpenvpnClientResource{}
+	_ resource.ResourceWithImportState = &vpnOpenvpnClientResource{}
+)
+
+func NewVpnOpenvpnClientResource() resource.Resource {
+	return &vpnOpenvpnClientResource{}
+}
+
+type vpnOpenvpnClientResource struct {
+	client *unifiClient
+}
+
+func (r *vpnOpenvpnClientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpn_openvpn_client"
+}
+
+func (r *vpnOpenvpnClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_vpn_openvpn_client.VpnOpenvpnClientResourceSchema(ctx)
+}
+
+func (r *vpnOpenvpnClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nill check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *vpnOpenvpnClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *vpnOpenvpnClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create OpenVPN Client")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_vpn_openvpn_client.VpnOpenvpnClientModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var body networkConf
+	parseVpnOpenvpnClientResourceModel(data, &body)
+	network, err := r.client.api.createNetworkConf(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating OpenVPN Client",
+			"Could not create OpenVPN Client, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseVpnOpenvpnClientResourceJson(*network, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *network)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *vpnOpenvpnClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_vpn_openvpn_client.VpnOpenvpnClientModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed OpenVPN Client value from Unifi
+	network, err := r.client.api.getNetworkConf(ctx, data.Site.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading OpenVPN Client",
+			"Could not read OpenVPN Client ID "+data.Id.ValueString()+"; "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseVpnOpenvpnClientResourceJson(*network, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *network)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *vpnOpenvpnClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update OpenVPN Client")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_vpn_openvpn_client.VpnOpenvpnClientModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := readForUpdate(ctx, r.client, req.Private, "OpenVPN Client", func() (*networkConf, error) {
+		return r.client.api.getNetworkConf(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	parseVpnOpenvpnClientResourceModel(data, &body)
+	network, err := r.client.api.updateNetworkConf(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating OpenVPN Client",
+			"Could not update OpenVPN Client, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseVpnOpenvpnClientResourceJson(*network, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *network)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *vpnOpenvpnClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete OpenVPN Client")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_vpn_openvpn_client.VpnOpenvpnClientModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing OpenVPN Client
+	err := r.client.DeleteNetwork(ctx, data.Site.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting OpenVPN Client",
+			"Could not delete OpenVPN Client, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func parseVpnOpenvpnClientResourceJson(json networkConf, model *resource_vpn_openvpn_client.VpnOpenvpnClientModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if json.VPNType != "openvpn-client" {
+		diags.AddError(
+			"Unexpected Network VPN Type",
+			fmt.Sprintf("Network ID %s has VPN type %q, only networks with VPN type \"openvpn-client\" can be managed as an OpenVPN Client.", json.ID, json.VPNType),
+		)
+		return diags
+	}
+
+	mapVpnOpenvpnClientResourceJson(json, model)
+
+	return diags
+}
+
+func parseVpnOpenvpnClientResourceModel(model resource_vpn_openvpn_client.VpnOpenvpnClientModel, json *networkConf) {
+	mapVpnOpenvpnClientResourceModel(model, json)
+	json.Purpose = "vpn-client"
+	json.VPNType = "openvpn-client"
+}
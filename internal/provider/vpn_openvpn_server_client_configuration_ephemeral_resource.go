@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &vpnOpenvpnServerClientConfigurationEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &vpnOpenvpnServerClientConfigurationEphemeralResource{}
+)
+
+func NewVpnOpenvpnServerClientConfigurationEphemeralResource() ephemeral.EphemeralResource {
+	return &vpnOpenvpnServerClientConfigurationEphemeralResource{}
+}
+
+// vpnOpenvpnServerClientConfigurationEphemeralResource exports the client
+// configuration of an OpenVPN Server. It is ephemeral, so the certificates
+// and keys in it are never stored in the state.
+type vpnOpenvpnServerClientConfigurationEphemeralResource struct {
+	client *unifiClient
+}
+
+type vpnOpenvpnServerClientConfigurationModel struct {
+	Configuration types.String `tfsdk:"configuration"`
+	ServerId      types.String `tfsdk:"server_id"`
+	Site          types.String `tfsdk:"site"`
+}
+
+func (r *vpnOpenvpnServerClientConfigurationEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpn_openvpn_server_client_configuration"
+}
+
+func (r *vpnOpenvpnServerClientConfigurationEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"configuration": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "The contents of the .ovpn client configuration file of the OpenVPN Server.",
+				MarkdownDescription: "The contents of the .ovpn client configuration file of the OpenVPN Server.",
+			},
+			"server_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the `unifi_vpn_openvpn_server` to export the client configuration of.",
+				MarkdownDescription: "The ID of the `unifi_vpn_openvpn_server` to export the client configuration of.",
+			},
+			"site": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the site the OpenVPN Server is associated with.",
+				MarkdownDescription: "The name of the site the OpenVPN Server is associated with.",
+			},
+		},
+	}
+}
+
+func (r *vpnOpenvpnServerClientConfigurationEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Add a nill check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *vpnOpenvpnServerClientConfigurationEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data vpnOpenvpnServerClientConfigurationModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := r.client.api.getOpenVPNClientConfiguration(ctx, data.Site.ValueString(), data.ServerId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading OpenVPN client configuration",
+			"Could not export the client configuration of OpenVPN Server ID "+data.ServerId.ValueString()+"; "+err.Error(),
+		)
+		return
+	}
+
+	data.Configuration = types.StringValue(configuration)
+
+	// Save data into ephemeral result data
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// getOpenVPNClientConfiguration returns the .ovpn client configuration file
+// of the OpenVPN server networkID, as downloaded from the Unifi UI.
+func (c *apiClient) getOpenVPNClientConfiguration(ctx context.Context, site, networkID string) (string, error) {
+	b, err := c.send(ctx, http.MethodGet, "v2/api/site/"+url.PathEscape(site)+"/vpn/openvpn/"+url.PathEscape(networkID)+"/configuration", "*/*", nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
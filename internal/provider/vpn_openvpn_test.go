@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_vpn_openvpn_client"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_vpn_openvpn_server"
+)
+
+func TestVpnOpenvpnClientResourceMapping(t *testing.T) {
+	model := resource_vpn_openvpn_client.VpnOpenvpnClientModel{
+		Name:          types.StringValue("VPN Provider"),
+		Configuration: types.StringValue("client\nremote vpn.example.com 1194\n"),
+		Username:      types.StringValue("customer"),
+		Password:      types.StringValue("secret"),
+		DefaultRoute:  types.BoolValue(false),
+	}
+
+	var body networkConf
+	parseVpnOpenvpnClientResourceModel(model, &body)
+	assert.Equal(t, "vpn-client", body.Purpose)
+	assert.Equal(t, "openvpn-client", body.VPNType)
+	assert.Equal(t, "secret", body.XOpenVPNPassword)
+
+	var state resource_vpn_openvpn_client.VpnOpenvpnClientModel
+	diags := parseVpnOpenvpnClientResourceJson(body, &state)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, model.Configuration, state.Configuration)
+	assert.Equal(t, types.BoolValue(false), state.DefaultRoute)
+
+	body.VPNType = "wireguard-client"
+	diags = parseVpnOpenvpnClientResourceJson(body, &state)
+	assert.True(t, diags.HasError())
+}
+
+func TestVpnOpenvpnServerResourceMapping(t *testing.T) {
+	ctx := context.Background()
+
+	model := resource_vpn_openvpn_server.VpnOpenvpnServerModel{
+		Name:     types.StringValue("Remote Access"),
+		Port:     types.Int64Value(1194),
+		Protocol: types.StringValue("udp"),
+		Dns:      types.ListNull(types.StringType),
+	}
+
+	var body networkConf
+	diags := parseVpnOpenvpnServerResourceModel(ctx, model, &body)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "remote-user-vpn", body.Purpose)
+	assert.Equal(t, "openvpn-server", body.VPNType)
+	assert.Equal(t, 1194, body.LocalPort)
+	assert.False(t, body.DHCPDDNSEnabled)
+
+	model.Dns, diags = types.ListValueFrom(ctx, types.StringType, []string{"10.1.0.1"})
+	assert.False(t, diags.HasError())
+
+	diags = parseVpnOpenvpnServerResourceModel(ctx, model, &body)
+	assert.False(t, diags.HasError(), diags)
+	assert.True(t, body.DHCPDDNSEnabled)
+
+	var state resource_vpn_openvpn_server.VpnOpenvpnServerModel
+	diags = parseVpnOpenvpnServerResourceJson(ctx, body, &state)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, model.Dns, state.Dns)
+
+	body.VPNType = "wireguard-server"
+	diags = parseVpnOpenvpnServerResourceJson(ctx, body, &state)
+	assert.True(t, diags.HasError())
+}
+
+func TestOpenVPNClientConfiguration(t *testing.T) {
+	client := newAPITestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/api/site/default/vpn/openvpn/ovpn-1/configuration" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("client\nremote 203.0.113.2 1194\n"))
+	})
+
+	configuration, err := client.getOpenVPNClientConfiguration(context.Background(), "default", "ovpn-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "client\nremote 203.0.113.2 1194\n", configuration)
+
+	_, err = client.getOpenVPNClientConfiguration(context.Background(), "default", "ovpn-2")
+	assert.ErrorContains(t, err, "unexpected status 404")
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_vpn_site_to_site"
+)
+
+var _ resource.ConfigValidator = vpnSiteToSiteConfigValidator{}
+
+// vpnSiteToSiteConfigValidator checks that the attributes of a
+// unifi_vpn_site_to_site match its mode, and that the tunnel has a
+// pre-shared key.
+type vpnSiteToSiteConfigValidator struct{}
+
+func (v vpnSiteToSiteConfigValidator) Description(_ context.Context) string {
+	return "Manual tunnels require peer_ip and one of pre_shared_key or pre_shared_key_wo, auto tunnels require " +
+		"remote_site_id and no IPsec attributes, tunnel_ip requires route_based and esp_dh_group requires pfs"
+}
+
+func (v vpnSiteToSiteConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v vpnSiteToSiteConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resource_vpn_site_to_site.VpnSiteToSiteModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateVpnSiteToSiteMode(data)...)
+	resp.Diagnostics.Append(validateVpnSiteToSiteKey(data)...)
+
+	if isSet(data.TunnelIp) && isSet(data.RouteBased) && !data.RouteBased.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tunnel_ip"),
+			"Invalid Site-to-Site VPN Configuration",
+			"The tunnel_ip attribute can only be set when route_based is true.",
+		)
+	}
+
+	if isSet(data.EspDhGroup) && isSet(data.Pfs) && !data.Pfs.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("esp_dh_group"),
+			"Invalid Site-to-Site VPN Configuration",
+			"The esp_dh_group attribute can only be set when pfs is true.",
+		)
+	}
+}
+
+// validateVpnSiteToSiteMode checks the attributes required by the mode, and
+// that auto tunnels, which are configured by the controller, have no IPsec
+// attributes.
+func validateVpnSiteToSiteMode(data resource_vpn_site_to_site.VpnSiteToSiteModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Mode.IsUnknown() {
+		return diags
+	}
+
+	// A null mode is the default, manual.
+	if data.Mode.ValueString() != "auto" {
+		if data.PeerIp.IsNull() {
+			diags.AddAttributeError(
+				path.Root("peer_ip"),
+				"Missing Site-to-Site VPN Configuration",
+				"The peer_ip attribute is required when mode is \"manual\".",
+			)
+		}
+
+		if data.RemoteSubnets.IsNull() && (data.RouteBased.IsNull() || !data.RouteBased.ValueBool()) {
+			diags.AddAttributeError(
+				path.Root("remote_subnets"),
+				"Missing Site-to-Site VPN Configuration",
+				"The remote_subnets attribute is required for policy-based tunnels, i.e. when route_based is false.",
+			)
+		}
+
+		if isSet(data.RemoteSiteId) {
+			diags.AddAttributeError(
+				path.Root("remote_site_id"),
+				"Invalid Site-to-Site VPN Configuration",
+				"The remote_site_id attribute can only be set when mode is \"auto\".",
+			)
+		}
+
+		return diags
+	}
+
+	if data.RemoteSiteId.IsNull() {
+		diags.AddAttributeError(
+			path.Root("remote_site_id"),
+			"Missing Site-to-Site VPN Configuration",
+			"The remote_site_id attribute is required when mode is \"auto\".",
+		)
+	}
+
+	attributes := map[string]attr.Value{
+		"esp_dh_group":      data.EspDhGroup,
+		"esp_encryption":    data.EspEncryption,
+		"esp_hash":          data.EspHash,
+		"esp_lifetime":      data.EspLifetime,
+		"ike_dh_group":      data.IkeDhGroup,
+		"ike_encryption":    data.IkeEncryption,
+		"ike_hash":          data.IkeHash,
+		"ike_lifetime":      data.IkeLifetime,
+		"ike_version":       data.IkeVersion,
+		"local_identifier":  data.LocalIdentifier,
+		"peer_ip":           data.PeerIp,
+		"pfs":               data.Pfs,
+		"pre_shared_key":    data.PreSharedKey,
+		"pre_shared_key_wo": data.PreSharedKeyWo,
+		"remote_identifier": data.RemoteIdentifier,
+		"remote_subnets":    data.RemoteSubnets,
+		"route_based":       data.RouteBased,
+		"tunnel_ip":         data.TunnelIp,
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		if isSet(attributes[name]) {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid Site-to-Site VPN Configuration",
+				fmt.Sprintf("The %s attribute can only be set when mode is \"manual\".", name),
+			)
+		}
+	}
+
+	return diags
+}
+
+// validateVpnSiteToSiteKey checks that a manual tunnel has exactly one of
+// pre_shared_key and pre_shared_key_wo, and that the write-only key has a
+// version.
+func validateVpnSiteToSiteKey(data resource_vpn_site_to_site.VpnSiteToSiteModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Mode.ValueString() == "auto" || data.Mode.IsUnknown() {
+		return diags
+	}
+
+	switch {
+	case !data.PreSharedKey.IsNull() && !data.PreSharedKeyWo.IsNull():
+		diags.AddAttributeError(
+			path.Root("pre_shared_key_wo"),
+			"Invalid Site-to-Site VPN Configuration",
+			"Only one of pre_shared_key and pre_shared_key_wo can be set.",
+		)
+	case data.PreSharedKey.IsNull() && data.PreSharedKeyWo.IsNull():
+		diags.AddAttributeError(
+			path.Root("pre_shared_key"),
+			"Missing Site-to-Site VPN Configuration",
+			"One of pre_shared_key or pre_shared_key_wo is required when mode is \"manual\".",
+		)
+	}
+
+	if !data.PreSharedKeyWo.IsNull() && data.PreSharedKeyWoVersion.IsNull() {
+		diags.AddAttributeError(
+			path.Root("pre_shared_key_wo_version"),
+			"Missing Site-to-Site VPN Configuration",
+			"The pre_shared_key_wo_version attribute is required with pre_shared_key_wo, the key is only updated when the version changes.",
+		)
+	}
+
+	return diags
+}
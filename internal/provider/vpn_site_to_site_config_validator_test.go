@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_vpn_site_to_site"
+)
+
+func TestValidateVpnSiteToSiteMode(t *testing.T) {
+	subnets, diags := types.ListValueFrom(context.Background(), types.StringType, []string{"10.20.0.0/16"})
+	assert.False(t, diags.HasError())
+
+	model := resource_vpn_site_to_site.VpnSiteToSiteModel{
+		PeerIp:        types.StringValue("198.51.100.7"),
+		RemoteSubnets: subnets,
+	}
+	assert.False(t, validateVpnSiteToSiteMode(model).HasError())
+
+	model.RemoteSubnets = types.ListNull(types.StringType)
+	assert.Equal(t, 1, validateVpnSiteToSiteMode(model).ErrorsCount())
+
+	model.RouteBased = types.BoolValue(true)
+	assert.False(t, validateVpnSiteToSiteMode(model).HasError())
+
+	model.Mode = types.StringValue("auto")
+	assert.Equal(t, 3, validateVpnSiteToSiteMode(model).ErrorsCount())
+
+	model = resource_vpn_site_to_site.VpnSiteToSiteModel{
+		Mode:         types.StringValue("auto"),
+		RemoteSiteId: types.StringValue("5f1a2b3c4d5e6f7a8b9c0d1e"),
+	}
+	assert.False(t, validateVpnSiteToSiteMode(model).HasError())
+}
+
+func TestValidateVpnSiteToSiteKey(t *testing.T) {
+	model := resource_vpn_site_to_site.VpnSiteToSiteModel{
+		PreSharedKey: types.StringValue("secret"),
+	}
+	assert.False(t, validateVpnSiteToSiteKey(model).HasError())
+
+	model.PreSharedKeyWo = types.StringValue("secret")
+	assert.Equal(t, 2, validateVpnSiteToSiteKey(model).ErrorsCount())
+
+	model.PreSharedKey = types.StringNull()
+	model.PreSharedKeyWoVersion = types.Int64Value(1)
+	assert.False(t, validateVpnSiteToSiteKey(model).HasError())
+
+	model.PreSharedKeyWo = types.StringNull()
+	assert.Equal(t, 1, validateVpnSiteToSiteKey(model).ErrorsCount())
+
+	model.Mode = types.StringValue("auto")
+	assert.False(t, validateVpnSiteToSiteKey(model).HasError())
+}
+
+func TestVpnSiteToSiteResourceMapping(t *testing.T) {
+	ctx := context.Background()
+
+	model := resource_vpn_site_to_site.VpnSiteToSiteModel{
+		Name:         types.StringValue("Branch 12"),
+		Mode:         types.StringValue("manual"),
+		PeerIp:       types.StringValue("198.51.100.7"),
+		PreSharedKey: types.StringNull(),
+		IkeVersion:   types.StringValue("ikev2"),
+		IkeDhGroup:   types.Int64Value(14),
+	}
+
+	var body networkConf
+	diags := parseVpnSiteToSiteResourceModel(ctx, model, types.StringValue("write-only"), &body)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "site-vpn", body.Purpose)
+	assert.Equal(t, "ipsec-vpn", body.VPNType)
+	assert.Equal(t, "write-only", body.XIPSecPreSharedKey)
+	assert.Equal(t, 14, body.IPSecIkeDhGroup)
+
+	// The write-only key isn't stored in the state.
+	var state resource_vpn_site_to_site.VpnSiteToSiteModel
+	diags = parseVpnSiteToSiteResourceJson(ctx, body, &state)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, types.StringValue("manual"), state.Mode)
+	assert.True(t, state.PreSharedKey.IsNull())
+
+	state.PreSharedKey = types.StringValue("old")
+	diags = parseVpnSiteToSiteResourceJson(ctx, body, &state)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, types.StringValue("write-only"), state.PreSharedKey)
+
+	body.VPNType = "wireguard-server"
+	assert.True(t, parseVpnSiteToSiteResourceJson(ctx, body, &state).HasError())
+}
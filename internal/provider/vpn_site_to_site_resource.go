@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_vpn_site_to_site"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &vpnSiteToSiteResource{}
+	_ resource.ResourceWithConfigure        = &vpnSiteToSiteResource{}
+	_ resource.ResourceWithConfigValidators = &vpnSiteToSiteResource{}
+	_ resource.ResourceWithImportState      = &vpnSiteToSiteResource{}
+)
+
+func NewVpnSiteToSiteResource() resource.Resource {
+	return &vpnSiteToSiteResource{}
+}
+
+type vpnSiteToSiteResource struct {
+	client *unifiClient
+}
+
+func (r *vpnSiteToSiteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpn_site_to_site"
+}
+
+func (r *vpnSiteToSiteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_vpn_site_to_site.VpnSiteToSiteResourceSchema(ctx)
+}
+
+func (r *vpnSiteToSiteResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		vpnSiteToSiteConfigValidator{},
+	}
+}
+
+func (r *vpnSiteToSiteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nill check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *vpnSiteToSiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *vpnSiteToSiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create Site-to-Site VPN")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_vpn_site_to_site.VpnSiteToSiteModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var preSharedKeyWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("pre_shared_key_wo"), &preSharedKeyWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var body networkConf
+	resp.Diagnostics.Append(parseVpnSiteToSiteResourceModel(ctx, data, preSharedKeyWo, &body)...)
+	network, err := r.client.api.createNetworkConf(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Site-to-Site VPN",
+			"Could not create Site-to-Site VPN, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseVpnSiteToSiteResourceJson(ctx, *network, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *network)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *vpnSiteToSiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_vpn_site_to_site.VpnSiteToSiteModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed Site-to-Site VPN value from Unifi
+	network, err := r.client.api.getNetworkConf(ctx, data.Site.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Site-to-Site VPN",
+			"Could not read Site-to-Site VPN ID "+data.Id.ValueString()+"; "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseVpnSiteToSiteResourceJson(ctx, *network, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *network)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *vpnSiteToSiteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update Site-to-Site VPN")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_vpn_site_to_site.VpnSiteToSiteModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := readForUpdate(ctx, r.client, req.Private, "Site-to-Site VPN", func() (*networkConf, error) {
+		return r.client.api.getNetworkConf(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The write-only key is only sent when its version changes, otherwise
+	// the current key is kept.
+	var preSharedKeyWo types.String
+	var priorVersion types.Int64
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("pre_shared_key_wo_version"), &priorVersion)...)
+	if !data.PreSharedKeyWoVersion.Equal(priorVersion) {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("pre_shared_key_wo"), &preSharedKeyWo)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	resp.Diagnostics.Append(parseVpnSiteToSiteResourceModel(ctx, data, preSharedKeyWo, &body)...)
+	network, err := r.client.api.updateNetworkConf(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Site-to-Site VPN",
+			"Could not update Site-to-Site VPN, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseVpnSiteToSiteResourceJson(ctx, *network, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *network)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *vpnSiteToSiteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete Site-to-Site VPN")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_vpn_site_to_site.VpnSiteToSiteModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing Site-to-Site VPN
+	err := r.client.DeleteNetwork(ctx, data.Site.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Site-to-Site VPN",
+			"Could not delete Site-to-Site VPN, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func parseVpnSiteToSiteResourceJson(ctx context.Context, json networkConf, model *resource_vpn_site_to_site.VpnSiteToSiteModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	mode, ok := vpnSiteToSiteModes[json.VPNType]
+	if json.Purpose != "site-vpn" || !ok {
+		diags.AddError(
+			"Unexpected Network VPN Type",
+			fmt.Sprintf("Network ID %s has purpose %q and VPN type %q, only IPsec site-to-site VPNs can be managed as a Site-to-Site VPN.", json.ID, json.Purpose, json.VPNType),
+		)
+		return diags
+	}
+
+	diags = mapVpnSiteToSiteResourceJson(ctx, json, model)
+	model.Mode = types.StringValue(mode)
+
+	// The key is only kept in the state when it is configured with
+	// pre_shared_key, not with the write-only attribute.
+	if !model.PreSharedKey.IsNull() {
+		model.PreSharedKey = types.StringValue(json.XIPSecPreSharedKey)
+	}
+
+	return diags
+}
+
+// parseVpnSiteToSiteResourceModel sets the fields of json from model. The
+// write-only pre-shared key isn't part of the model, it is passed separately
+// when it has to be sent.
+func parseVpnSiteToSiteResourceModel(ctx context.Context, model resource_vpn_site_to_site.VpnSiteToSiteModel, preSharedKeyWo types.String, json *networkConf) diag.Diagnostics {
+	diags := mapVpnSiteToSiteResourceModel(ctx, model, json)
+	json.Purpose = "site-vpn"
+	json.VPNType = "ipsec-vpn"
+	if model.Mode.ValueString() == "auto" {
+		json.VPNType = "auto"
+	}
+
+	switch {
+	case !model.PreSharedKey.IsNull() && !model.PreSharedKey.IsUnknown():
+		json.XIPSecPreSharedKey = model.PreSharedKey.ValueString()
+	case !preSharedKeyWo.IsNull() && !preSharedKeyWo.IsUnknown():
+		json.XIPSecPreSharedKey = preSharedKeyWo.ValueString()
+	}
+
+	return diags
+}
+
+// vpnSiteToSiteModes maps the VPN types of the controller to the mode of a
+// unifi_vpn_site_to_site.
+var vpnSiteToSiteModes = map[string]string{
+	"auto":      "auto",
+	"ipsec-vpn": "manual",
+}
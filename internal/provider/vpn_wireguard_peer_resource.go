@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_vpn_wireguard_peer"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &vpnWireguardPeerResource{}
+	_ resource.ResourceWithConfigure   = &vpnWireguardPeerResource{}
+	_ resource.ResourceWithImportState = &vpnWireguardPeerResource{}
+	_ resource.ResourceWithModifyPlan  = &vpnWireguardPeerResource{}
+)
+
+func NewVpnWireguardPeerResource() resource.Resource {
+	return &vpnWireguardPeerResource{}
+}
+
+type vpnWireguardPeerResource struct {
+	client *unifiClient
+}
+
+func (r *vpnWireguardPeerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpn_wireguard_peer"
+}
+
+func (r *vpnWireguardPeerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_vpn_wireguard_peer.VpnWireguardPeerResourceSchema(ctx)
+}
+
+func (r *vpnWireguardPeerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nill check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *vpnWireguardPeerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *vpnWireguardPeerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var publicKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("public_key"), &publicKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A supplied public key replaces the generated key pair.
+	if !publicKey.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("private_key"), types.StringNull())...)
+		return
+	}
+
+	// Generate a key pair again when a supplied public key is removed from
+	// the configuration.
+	if !req.State.Raw.IsNull() {
+		var privateKey types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("private_key"), &privateKey)...)
+		if privateKey.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("public_key"), types.StringUnknown())...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("private_key"), types.StringUnknown())...)
+		}
+	}
+}
+
+func (r *vpnWireguardPeerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create WireGuard Peer")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_vpn_wireguard_peer.VpnWireguardPeerModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(generateWireguardPeerKey(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var body wireguardPeer
+	resp.Diagnostics.Append(mapVpnWireguardPeerResourceModel(ctx, data, &body)...)
+	peer, err := r.client.api.createWireguardPeer(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating WireGuard Peer",
+			"Could not create WireGuard Peer, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.parseVpnWireguardPeerResourceJson(ctx, *peer, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *peer)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *vpnWireguardPeerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_vpn_wireguard_peer.VpnWireguardPeerModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed WireGuard Peer value from Unifi
+	peer, err := r.client.api.getWireguardPeer(ctx, data.Site.ValueString(), data.ServerId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading WireGuard Peer",
+			"Could not read WireGuard Peer ID "+data.Id.ValueString()+"; "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.parseVpnWireguardPeerResourceJson(ctx, *peer, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *peer)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *vpnWireguardPeerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update WireGuard Peer")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_vpn_wireguard_peer.VpnWireguardPeerModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(generateWireguardPeerKey(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := readForUpdate(ctx, r.client, req.Private, "WireGuard Peer", func() (*wireguardPeer, error) {
+		return r.client.api.getWireguardPeer(ctx, data.Site.ValueString(), data.ServerId.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	resp.Diagnostics.Append(mapVpnWireguardPeerResourceModel(ctx, data, &body)...)
+	peer, err := r.client.api.updateWireguardPeer(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating WireGuard Peer",
+			"Could not update WireGuard Peer, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.parseVpnWireguardPeerResourceJson(ctx, *peer, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *peer)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *vpnWireguardPeerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete WireGuard Peer")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_vpn_wireguard_peer.VpnWireguardPeerModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing WireGuard Peer
+	err := r.client.api.deleteWireguardPeer(ctx, data.Site.ValueString(), data.ServerId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting WireGuard Peer",
+			"Could not delete WireGuard Peer, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// parseVpnWireguardPeerResourceJson sets the attributes of model from json
+// and renders the client configuration, which needs the server of the peer.
+func (r *vpnWireguardPeerResource) parseVpnWireguardPeerResourceJson(ctx context.Context, json wireguardPeer, model *resource_vpn_wireguard_peer.VpnWireguardPeerModel) diag.Diagnostics {
+	diags := mapVpnWireguardPeerResourceJson(ctx, json, model)
+	if diags.HasError() {
+		return diags
+	}
+
+	server, err := r.client.api.getNetworkConf(ctx, model.Site.ValueString(), json.NetworkID)
+	if err != nil {
+		diags.AddError(
+			"Error reading WireGuard Server",
+			"Could not read WireGuard Server ID "+json.NetworkID+" of the WireGuard Peer; "+err.Error(),
+		)
+		return diags
+	}
+
+	var clientAllowedIPs []string
+	if !model.ClientAllowedIps.IsNull() && !model.ClientAllowedIps.IsUnknown() {
+		diags.Append(model.ClientAllowedIps.ElementsAs(ctx, &clientAllowedIPs, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	model.ClientConfiguration = types.StringValue(wireguardClientConfig(json, model.PrivateKey.ValueString(), *server, model.Endpoint.ValueString(), clientAllowedIPs))
+
+	return diags
+}
+
+// generateWireguardPeerKey generates the key pair of a peer when the plan
+// has no public key.
+func generateWireguardPeerKey(model *resource_vpn_wireguard_peer.VpnWireguardPeerModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !model.PublicKey.IsUnknown() && !model.PublicKey.IsNull() {
+		return diags
+	}
+
+	privateKey, publicKey, err := generateWireguardKey()
+	if err != nil {
+		diags.AddError(
+			"Error generating WireGuard key",
+			"Could not generate the key pair of the WireGuard Peer, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	model.PrivateKey = types.StringValue(privateKey)
+	model.PublicKey = types.StringValue(publicKey)
+
+	return diags
+}
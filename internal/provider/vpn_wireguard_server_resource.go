@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_vpn_wireguard_server"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &vpnWireguardServerResource{}
+	_ resource.ResourceWithConfigure   = &vpnWireguardServerResource{}
+	_ resource.ResourceWithImportState = &vpnWireguardServerResource{}
+)
+
+func NewVpnWireguardServerResource() resource.Resource {
+	return &vpnWireguardServerResource{}
+}
+
+type vpnWireguardServerResource struct {
+	client *unifiClient
+}
+
+func (r *vpnWireguardServerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpn_wireguard_server"
+}
+
+func (r *vpnWireguardServerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_vpn_wireguard_server.VpnWireguardServerResourceSchema(ctx)
+}
+
+func (r *vpnWireguardServerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nill check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *vpnWireguardServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *vpnWireguardServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create WireGuard Server")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_vpn_wireguard_server.VpnWireguardServerModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var body networkConf
+	resp.Diagnostics.Append(parseVpnWireguardServerResourceModel(ctx, data, &body)...)
+	network, err := r.client.api.createNetworkConf(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating WireGuard Server",
+			"Could not create WireGuard Server, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseVpnWireguardServerResourceJson(ctx, *network, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *network)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *vpnWireguardServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_vpn_wireguard_server.VpnWireguardServerModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed WireGuard Server value from Unifi
+	network, err := r.client.api.getNetworkConf(ctx, data.Site.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading WireGuard Server",
+			"Could not read WireGuard Server ID "+data.Id.ValueString()+"; "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseVpnWireguardServerResourceJson(ctx, *network, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *network)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *vpnWireguardServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update WireGuard Server")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_vpn_wireguard_server.VpnWireguardServerModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := readForUpdate(ctx, r.client, req.Private, "WireGuard Server", func() (*networkConf, error) {
+		return r.client.api.getNetworkConf(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	resp.Diagnostics.Append(parseVpnWireguardServerResourceModel(ctx, data, &body)...)
+	network, err := r.client.api.updateNetworkConf(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating WireGuard Server",
+			"Could not update WireGuard Server, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseVpnWireguardServerResourceJson(ctx, *network, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *network)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *vpnWireguardServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete WireGuard Server")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_vpn_wireguard_server.VpnWireguardServerModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing WireGuard Server
+	err := r.client.DeleteNetwork(ctx, data.Site.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting WireGuard Server",
+			"Could not delete WireGuard Server, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func parseVpnWireguardServerResourceJson(ctx context.Context, json networkConf, model *resource_vpn_wireguard_server.VpnWireguardServerModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if json.VPNType != "wireguard-server" {
+		diags.AddError(
+			"Unexpected Network VPN Type",
+			fmt.Sprintf("Network ID %s has VPN type %q, only networks with VPN type \"wireguard-server\" can be managed as a WireGuard Server.", json.ID, json.VPNType),
+		)
+		return diags
+	}
+
+	return mapVpnWireguardServerResourceJson(ctx, json, model)
+}
+
+func parseVpnWireguardServerResourceModel(ctx context.Context, model resource_vpn_wireguard_server.VpnWireguardServerModel, json *networkConf) diag.Diagnostics {
+	diags := mapVpnWireguardServerResourceModel(ctx, model, json)
+	json.Purpose = "remote-user-vpn"
+	json.VPNType = "wireguard-server"
+
+	// The DNS servers are only handed to the peers when enabled.
+	json.DHCPDDNSEnabled = json.DHCPDDNS1 != ""
+
+	return diags
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_wan"
+)
+
+var _ resource.ConfigValidator = wanConfigValidator{}
+
+// wanConfigValidator checks that the attributes of a unifi_wan match its
+// connection types and toggles, e.g. a static WAN has an address and PPPoE
+// credentials are only set for PPPoE.
+type wanConfigValidator struct{}
+
+func (v wanConfigValidator) Description(_ context.Context) string {
+	return "Connection attributes must match type and ipv6_type, DNS servers require manual DNS, and VLAN, " +
+		"MAC override, smart queue and load balancing attributes must match their toggles"
+}
+
+func (v wanConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v wanConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resource_wan.WanModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateWanSelection("type", data.Type, map[string]map[string]attr.Value{
+		"static": {
+			"gateway": data.Gateway,
+			"ip":      data.Ip,
+			"netmask": data.Netmask,
+		},
+		"pppoe": {
+			"password": data.Password,
+			"username": data.Username,
+		},
+	})...)
+	resp.Diagnostics.Append(validateWanSelection("ipv6_type", data.Ipv6Type, map[string]map[string]attr.Value{
+		"static": {
+			"ipv6_address":       data.Ipv6Address,
+			"ipv6_gateway":       data.Ipv6Gateway,
+			"ipv6_prefix_length": data.Ipv6PrefixLength,
+		},
+	})...)
+	resp.Diagnostics.Append(validateWanOnlyFor("ipv6_type", data.Ipv6Type, "dhcpv6", "ipv6_pd_size", data.Ipv6PdSize)...)
+	resp.Diagnostics.Append(validateWanOnlyFor("dns_preference", data.DnsPreference, "manual", "dns", data.Dns)...)
+	resp.Diagnostics.Append(validateWanOnlyFor("ipv6_dns_preference", data.Ipv6DnsPreference, "manual", "ipv6_dns", data.Ipv6Dns)...)
+	resp.Diagnostics.Append(validateWanOnlyFor("load_balance_type", data.LoadBalanceType, "weighted", "load_balance_weight", data.LoadBalanceWeight)...)
+	resp.Diagnostics.Append(validateWanOnlyFor("load_balance_type", data.LoadBalanceType, "failover-only", "failover_priority", data.FailoverPriority)...)
+	resp.Diagnostics.Append(validateWanToggle("vlan_enabled", data.VlanEnabled, map[string]attr.Value{
+		"vlan_id": data.VlanId,
+	})...)
+	resp.Diagnostics.Append(validateWanToggle("mac_override_enabled", data.MacOverrideEnabled, map[string]attr.Value{
+		"mac_override": data.MacOverride,
+	})...)
+	resp.Diagnostics.Append(validateWanToggle("smart_queues_enabled", data.SmartQueuesEnabled, map[string]attr.Value{
+		"smart_queues_down_rate": data.SmartQueuesDownRate,
+		"smart_queues_up_rate":   data.SmartQueuesUpRate,
+	})...)
+}
+
+// validateWanSelection checks that the attributes of each group are set when
+// the selector, e.g. type, has the value of the group, and are not set
+// otherwise.
+func validateWanSelection(selectorName string, selector types.String, groups map[string]map[string]attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !isSet(selector) {
+		return diags
+	}
+
+	for _, value := range slices.Sorted(maps.Keys(groups)) {
+		attributes := groups[value]
+		for _, name := range slices.Sorted(maps.Keys(attributes)) {
+			switch {
+			case selector.ValueString() == value && attributes[name].IsNull():
+				diags.AddAttributeError(
+					path.Root(name),
+					"Missing WAN Configuration",
+					fmt.Sprintf("The %s attribute is required when %s is %q.", name, selectorName, value),
+				)
+			case selector.ValueString() != value && isSet(attributes[name]):
+				diags.AddAttributeError(
+					path.Root(name),
+					"Invalid WAN Configuration",
+					fmt.Sprintf("The %s attribute can only be set when %s is %q, but it is %q.", name, selectorName, value, selector.ValueString()),
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+// validateWanOnlyFor checks that an optional attribute is only set when the
+// selector has the given value.
+func validateWanOnlyFor(selectorName string, selector types.String, value string, name string, attribute attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if isSet(selector) && selector.ValueString() != value && isSet(attribute) {
+		diags.AddAttributeError(
+			path.Root(name),
+			"Invalid WAN Configuration",
+			fmt.Sprintf("The %s attribute can only be set when %s is %q, but it is %q.", name, selectorName, value, selector.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// validateWanToggle checks that the attributes are set when the toggle is
+// enabled, and are not set when it is disabled.
+func validateWanToggle(toggleName string, toggle types.Bool, attributes map[string]attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !isSet(toggle) {
+		return diags
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		switch {
+		case toggle.ValueBool() && attributes[name].IsNull():
+			diags.AddAttributeError(
+				path.Root(name),
+				"Missing WAN Configuration",
+				fmt.Sprintf("The %s attribute is required when %s is true.", name, toggleName),
+			)
+		case !toggle.ValueBool() && isSet(attributes[name]):
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid WAN Configuration",
+				fmt.Sprintf("The %s attribute can only be set when %s is true.", name, toggleName),
+			)
+		}
+	}
+
+	return diags
+}
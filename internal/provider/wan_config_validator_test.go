@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_wan"
+)
+
+func TestValidateWanSelection(t *testing.T) {
+	static := func(model resource_wan.WanModel) map[string]map[string]attr.Value {
+		return map[string]map[string]attr.Value{
+			"static": {
+				"gateway": model.Gateway,
+				"ip":      model.Ip,
+				"netmask": model.Netmask,
+			},
+		}
+	}
+
+	model := resource_wan.WanModel{
+		Type:    types.StringValue("static"),
+		Ip:      types.StringValue("203.0.113.2"),
+		Netmask: types.StringValue("255.255.255.0"),
+		Gateway: types.StringValue("203.0.113.1"),
+	}
+	assert.False(t, validateWanSelection("type", model.Type, static(model)).HasError())
+
+	model.Gateway = types.StringNull()
+	assert.Equal(t, 1, validateWanSelection("type", model.Type, static(model)).ErrorsCount())
+
+	model.Type = types.StringValue("dhcp")
+	assert.Equal(t, 2, validateWanSelection("type", model.Type, static(model)).ErrorsCount())
+
+	model.Type = types.StringUnknown()
+	assert.False(t, validateWanSelection("type", model.Type, static(model)).HasError())
+}
+
+func TestValidateWanOnlyFor(t *testing.T) {
+	weight := types.Int64Value(50)
+
+	assert.False(t, validateWanOnlyFor("load_balance_type", types.StringValue("weighted"), "weighted", "load_balance_weight", weight).HasError())
+	assert.Equal(t, 1, validateWanOnlyFor("load_balance_type", types.StringValue("failover-only"), "weighted", "load_balance_weight", weight).ErrorsCount())
+	assert.False(t, validateWanOnlyFor("load_balance_type", types.StringNull(), "weighted", "load_balance_weight", weight).HasError())
+}
+
+func TestValidateWanToggle(t *testing.T) {
+	rates := map[string]attr.Value{
+		"smart_queues_down_rate": types.Int64Value(100000),
+		"smart_queues_up_rate":   types.Int64Null(),
+	}
+
+	assert.Equal(t, 1, validateWanToggle("smart_queues_enabled", types.BoolValue(true), rates).ErrorsCount())
+	assert.Equal(t, 1, validateWanToggle("smart_queues_enabled", types.BoolValue(false), rates).ErrorsCount())
+	assert.False(t, validateWanToggle("smart_queues_enabled", types.BoolNull(), rates).HasError())
+}
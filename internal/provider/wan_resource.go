@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_wan"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &wanResource{}
+	_ resource.ResourceWithConfigure        = &wanResource{}
+	_ resource.ResourceWithConfigValidators = &wanResource{}
+	_ resource.ResourceWithImportState      = &wanResource{}
+	_ resource.ResourceWithModifyPlan       = &wanResource{}
+)
+
+func NewWanResource() resource.Resource {
+	return &wanResource{}
+}
+
+type wanResource struct {
+	client *unifiClient
+}
+
+func (r *wanResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wan"
+}
+
+func (r *wanResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_wan.WanResourceSchema(ctx)
+}
+
+func (r *wanResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		wanConfigValidator{},
+	}
+}
+
+func (r *wanResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nill check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unifiClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *unifiClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *wanResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *wanResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only destroy plans need to be checked.
+	if !req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var prior resource_wan.WanModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkDeletionProtection(prior.DeletionProtection, "WAN")...)
+}
+
+func (r *wanResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create WAN")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_wan.WanModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var body networkConf
+	resp.Diagnostics.Append(parseWanResourceModel(ctx, data, &body)...)
+	network, err := r.client.api.createNetworkConf(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating WAN",
+			"Could not create WAN, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseWanResourceJson(ctx, *network, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *network)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *wanResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_wan.WanModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed WAN value from Unifi
+	network, err := r.client.api.getNetworkConf(ctx, data.Site.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading WAN",
+			"Could not read WAN ID "+data.Id.ValueString()+"; "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseWanResourceJson(ctx, *network, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *network)...)
+
+	data.DeletionProtection = deletionProtectionValue(data.DeletionProtection)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *wanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update WAN")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_wan.WanModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := readForUpdate(ctx, r.client, req.Private, "WAN", func() (*networkConf, error) {
+		return r.client.api.getNetworkConf(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
+	resp.Diagnostics.Append(parseWanResourceModel(ctx, data, &body)...)
+	network, err := r.client.api.updateNetworkConf(ctx, data.Site.ValueString(), &body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating WAN",
+			"Could not update WAN, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(parseWanResourceJson(ctx, *network, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *network)...)
+
+	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *wanResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete WAN")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data resource_wan.WanModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkDeletionProtection(data.DeletionProtection, "WAN")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing WAN
+	err := r.client.DeleteNetwork(ctx, data.Site.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting WAN",
+			"Could not delete WAN, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func parseWanResourceJson(ctx context.Context, json networkConf, model *resource_wan.WanModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// A unifi_wan can't manage LAN or VPN networks, e.g. after importing
+	// the wrong ID.
+	if json.Purpose != "wan" {
+		diags.AddError(
+			"Unexpected Network Purpose",
+			fmt.Sprintf("Network ID %s has purpose %q, only networks with purpose \"wan\" can be managed as a WAN. "+
+				"Use the unifi_network resource instead.", json.ID, json.Purpose),
+		)
+		return diags
+	}
+
+	return mapWanResourceJson(ctx, json, model)
+}
+
+func parseWanResourceModel(ctx context.Context, model resource_wan.WanModel, json *networkConf) diag.Diagnostics {
+	diags := mapWanResourceModel(ctx, model, json)
+	json.Purpose = "wan"
+
+	return diags
+}
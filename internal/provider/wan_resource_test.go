@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/resource_wan"
+)
+
+func TestWanResourceMapping(t *testing.T) {
+	ctx := context.Background()
+
+	dns, diags := types.ListValueFrom(ctx, types.StringType, []string{"1.1.1.1", "9.9.9.9"})
+	assert.False(t, diags.HasError())
+
+	model := resource_wan.WanModel{
+		Name:                types.StringValue("Fiber"),
+		NetworkGroup:        types.StringValue("WAN"),
+		Type:                types.StringValue("pppoe"),
+		Username:            types.StringValue("user"),
+		Password:            types.StringValue("secret"),
+		DnsPreference:       types.StringValue("manual"),
+		Dns:                 dns,
+		MacOverride:         customtypes.NewMacAddressValue("00-11-22-AA-BB-CC"),
+		SmartQueuesEnabled:  types.BoolValue(true),
+		SmartQueuesDownRate: types.Int64Value(500000),
+		SmartQueuesUpRate:   types.Int64Value(100000),
+	}
+
+	var body networkConf
+	diags = parseWanResourceModel(ctx, model, &body)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "wan", body.Purpose)
+	assert.Equal(t, "pppoe", body.WANType)
+	assert.Equal(t, "secret", body.XWANPassword)
+	assert.Equal(t, "9.9.9.9", body.WANDNS2)
+	assert.Equal(t, "00:11:22:aa:bb:cc", body.MACOverride)
+	assert.Equal(t, 500000, body.WANSmartqDownRate)
+
+	var state resource_wan.WanModel
+	diags = parseWanResourceJson(ctx, body, &state)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, dns, state.Dns)
+	assert.True(t, state.Ipv6Dns.IsNull())
+	assert.Equal(t, types.Int64Value(100000), state.SmartQueuesUpRate)
+
+	body.Purpose = "corporate"
+	diags = parseWanResourceJson(ctx, body, &state)
+	assert.True(t, diags.HasError())
+}
+
+func TestWanResource_ParseModelKeepsUnconfiguredSettings(t *testing.T) {
+	ctx := context.Background()
+	current := networkConf{
+		ID:                   "wan-123",
+		Purpose:              "wan",
+		Name:                 "Fiber",
+		WANType:              "dhcp",
+		WANDNSPreference:     "manual",
+		WANDNS1:              "1.1.1.1",
+		WANDNS2:              "9.9.9.9",
+		WANTypeV6:            "dhcpv6",
+		WANIPV6DNSPreference: "manual",
+		WANIPV6DNS1:          "2606:4700:4700::1111",
+		WANSmartqEnabled:     true,
+		WANSmartqDownRate:    500000,
+		WANSmartqUpRate:      100000,
+		WANMonitors:          []string{"1.1.1.1", "8.8.8.8"},
+	}
+
+	// Only the name changes, the other attributes aren't configured.
+	model := resource_wan.WanModel{
+		Id:                   types.StringValue("wan-123"),
+		Name:                 types.StringValue("Fiber 2"),
+		Type:                 types.StringUnknown(),
+		DnsPreference:        types.StringUnknown(),
+		Dns:                  types.ListUnknown(types.StringType),
+		Ipv6Type:             types.StringUnknown(),
+		Ipv6DnsPreference:    types.StringUnknown(),
+		Ipv6Dns:              types.ListUnknown(types.StringType),
+		SmartQueuesEnabled:   types.BoolUnknown(),
+		SmartQueuesDownRate:  types.Int64Unknown(),
+		SmartQueuesUpRate:    types.Int64Unknown(),
+		ConnectivityMonitors: types.ListUnknown(types.StringType),
+	}
+
+	body := current
+	diags := parseWanResourceModel(ctx, model, &body)
+	assert.False(t, diags.HasError(), diags)
+
+	expected := current
+	expected.Name = "Fiber 2"
+	assert.Equal(t, expected, body)
+}
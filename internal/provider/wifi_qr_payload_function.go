@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &wifiQrPayloadFunction{}
+
+// wifiQrEscaper escapes the characters with a special meaning in the fields
+// of a WiFi QR code payload.
+var wifiQrEscaper = strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, `:`, `\:`, `"`, `\"`)
+
+func NewWifiQrPayloadFunction() function.Function {
+	return &wifiQrPayloadFunction{}
+}
+
+// wifiQrPayloadFunction builds the payload of a QR code phones can scan to
+// join a WLAN.
+type wifiQrPayloadFunction struct{}
+
+func (f *wifiQrPayloadFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "wifi_qr_payload"
+}
+
+func (f *wifiQrPayloadFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the payload of a WiFi QR code.",
+		MarkdownDescription: "Builds the payload of a QR code to join a WLAN, e.g. `WIFI:T:WPA;S:Guest;P:secret;;`, to print for guests. " +
+			"Encode the result with any QR code generator.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ssid",
+				MarkdownDescription: "The SSID of the WLAN.",
+			},
+			function.StringParameter{
+				Name:                "security",
+				MarkdownDescription: "The security of the WLAN, as in the `security` attribute of `unifi_wlan`. Must be `wpapsk` or `open`, as WPA Enterprise networks can't be joined with a QR code.",
+			},
+			function.StringParameter{
+				Name:                "passphrase",
+				MarkdownDescription: "The passphrase of the WLAN. Ignored for open networks.",
+			},
+			function.BoolParameter{
+				Name:                "hidden",
+				MarkdownDescription: "Whether the SSID is hidden, as in the `hide_ssid` attribute of `unifi_wlan`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *wifiQrPayloadFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ssid, security, passphrase string
+	var hidden bool
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ssid, &security, &passphrase, &hidden))
+	if resp.Error != nil {
+		return
+	}
+
+	payload, funcErr := wifiQrPayload(ssid, security, passphrase, hidden)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, payload))
+}
+
+// wifiQrPayload returns the payload of a WiFi QR code in the format
+// understood by Android and iOS.
+func wifiQrPayload(ssid, security, passphrase string, hidden bool) (string, *function.FuncError) {
+	if ssid == "" {
+		return "", function.NewArgumentFuncError(0, "The SSID must not be empty.")
+	}
+
+	var b strings.Builder
+	switch security {
+	case "wpapsk":
+		if passphrase == "" {
+			return "", function.NewArgumentFuncError(2, "The passphrase must not be empty for wpapsk networks.")
+		}
+		fmt.Fprintf(&b, "WIFI:T:WPA;S:%s;P:%s;", wifiQrEscaper.Replace(ssid), wifiQrEscaper.Replace(passphrase))
+	case "open":
+		fmt.Fprintf(&b, "WIFI:T:nopass;S:%s;", wifiQrEscaper.Replace(ssid))
+	default:
+		return "", function.NewArgumentFuncError(1, fmt.Sprintf("Expected security to be wpapsk or open. Got: %q", security))
+	}
+
+	if hidden {
+		b.WriteString("H:true;")
+	}
+	b.WriteString(";")
+
+	return b.String(), nil
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWifiQrPayload(t *testing.T) {
+	payload, err := wifiQrPayload("Guest", "wpapsk", "secret", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "WIFI:T:WPA;S:Guest;P:secret;;", payload)
+
+	payload, err = wifiQrPayload(`My;Net,"x"`, "wpapsk", `p\a:ss`, true)
+	assert.Nil(t, err)
+	assert.Equal(t, `WIFI:T:WPA;S:My\;Net\,\"x\";P:p\\a\:ss;H:true;;`, payload)
+
+	payload, err = wifiQrPayload("Lobby", "open", "ignored", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "WIFI:T:nopass;S:Lobby;;", payload)
+
+	_, err = wifiQrPayload("Corp", "wpaeap", "", false)
+	assert.NotNil(t, err)
+
+	_, err = wifiQrPayload("Guest", "wpapsk", "", false)
+	assert.NotNil(t, err)
+
+	_, err = wifiQrPayload("", "open", "", false)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// generateWireguardKey returns a new base64 encoded WireGuard private key and
+// its public key.
+func generateWireguardKey() (string, string, error) {
+	key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(key.Bytes()), base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()), nil
+}
+
+// wireguardPublicKey returns the public key of a base64 encoded WireGuard
+// private key.
+func wireguardPublicKey(privateKey string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil {
+		return "", fmt.Errorf("invalid WireGuard private key: %w", err)
+	}
+
+	key, err := ecdh.X25519().NewPrivateKey(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid WireGuard private key: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()), nil
+}
+
+// wireguardDefaultAllowedIPs route all IPv4 and IPv6 traffic of a peer
+// through the tunnel.
+var wireguardDefaultAllowedIPs = []string{"0.0.0.0/0", "::/0"}
+
+// wireguardClientConfig returns the wg-quick configuration of a peer of
+// server, routing allowedIPs through the tunnel, or all traffic when empty.
+// The PrivateKey line is left out when the private key of the peer isn't
+// known, and the Endpoint line when there is no endpoint.
+func wireguardClientConfig(peer wireguardPeer, privateKey string, server networkConf, endpoint string, allowedIPs []string) string {
+	var b strings.Builder
+
+	b.WriteString("[Interface]\n")
+	if privateKey != "" {
+		fmt.Fprintf(&b, "PrivateKey = %s\n", privateKey)
+	}
+	fmt.Fprintf(&b, "Address = %s/32\n", peer.InterfaceIP)
+
+	dns := compactStrings(server.DHCPDDNS1, server.DHCPDDNS2, server.DHCPDDNS3, server.DHCPDDNS4)
+	if len(dns) == 0 {
+		// The server hands out its own address when no DNS servers are set.
+		if gateway, _, ok := strings.Cut(server.IPSubnet, "/"); ok {
+			dns = []string{gateway}
+		}
+	}
+	if len(dns) > 0 {
+		fmt.Fprintf(&b, "DNS = %s\n", strings.Join(dns, ", "))
+	}
+
+	b.WriteString("\n[Peer]\n")
+	fmt.Fprintf(&b, "PublicKey = %s\n", server.WireguardPublicKey)
+	if peer.PresharedKey != "" {
+		fmt.Fprintf(&b, "PresharedKey = %s\n", peer.PresharedKey)
+	}
+	if len(allowedIPs) == 0 {
+		allowedIPs = wireguardDefaultAllowedIPs
+	}
+	fmt.Fprintf(&b, "AllowedIPs = %s\n", strings.Join(allowedIPs, ", "))
+
+	if endpoint == "" {
+		endpoint = server.WireguardLocalWANIP
+	}
+	if endpoint != "" {
+		fmt.Fprintf(&b, "Endpoint = %s\n", net.JoinHostPort(endpoint, strconv.Itoa(server.LocalPort)))
+	}
+
+	return b.String()
+}
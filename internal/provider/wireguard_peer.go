@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// wireguardPeer is a peer of a WireGuard server, managed through the v2 API.
+type wireguardPeer struct {
+	ID           string   `json:"_id,omitempty"`
+	SiteID       string   `json:"site_id,omitempty"`
+	NetworkID    string   `json:"network_id,omitempty"`
+	Name         string   `json:"name,omitempty"`
+	InterfaceIP  string   `json:"interface_ip,omitempty"`
+	PublicKey    string   `json:"public_key,omitempty"`
+	PresharedKey string   `json:"preshared_key,omitempty"`
+	AllowedIPs   []string `json:"allowed_ips"`
+}
+
+func wireguardPeersPath(site, networkID string) string {
+	return "v2/api/site/" + url.PathEscape(site) + "/wireguard/" + url.PathEscape(networkID) + "/users"
+}
+
+func (c *apiClient) listWireguardPeers(ctx context.Context, site, networkID string) ([]wireguardPeer, error) {
+	var peers []wireguardPeer
+	if err := c.do(ctx, http.MethodGet, wireguardPeersPath(site, networkID), nil, &peers); err != nil {
+		return nil, err
+	}
+
+	return peers, nil
+}
+
+// getWireguardPeer returns the peer id of the WireGuard server networkID.
+// When networkID is empty, e.g. after an import, the peers of every
+// WireGuard server of site are searched.
+func (c *apiClient) getWireguardPeer(ctx context.Context, site, networkID, id string) (*wireguardPeer, error) {
+	networkIDs := []string{networkID}
+	if networkID == "" {
+		networks, err := c.listNetworkConf(ctx, site)
+		if err != nil {
+			return nil, err
+		}
+
+		networkIDs = nil
+		for _, network := range networks {
+			if network.VPNType == "wireguard-server" {
+				networkIDs = append(networkIDs, network.ID)
+			}
+		}
+	}
+
+	for _, networkID := range networkIDs {
+		peers, err := c.listWireguardPeers(ctx, site, networkID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, peer := range peers {
+			if peer.ID == id {
+				if peer.NetworkID == "" {
+					peer.NetworkID = networkID
+				}
+				return &peer, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("WireGuard peer %s not found", id)
+}
+
+// createWireguardPeer and updateWireguardPeer send the peer through the batch
+// endpoints of the WireGuard server, which the Unifi UI uses as well.
+func (c *apiClient) createWireguardPeer(ctx context.Context, site string, peer *wireguardPeer) (*wireguardPeer, error) {
+	return c.sendWireguardPeer(ctx, http.MethodPost, site, peer)
+}
+
+func (c *apiClient) updateWireguardPeer(ctx context.Context, site string, peer *wireguardPeer) (*wireguardPeer, error) {
+	return c.sendWireguardPeer(ctx, http.MethodPut, site, peer)
+}
+
+func (c *apiClient) sendWireguardPeer(ctx context.Context, method, site string, peer *wireguardPeer) (*wireguardPeer, error) {
+	var peers []wireguardPeer
+	if err := c.do(ctx, method, wireguardPeersPath(site, peer.NetworkID)+"/batch", []wireguardPeer{*peer}, &peers); err != nil {
+		return nil, err
+	}
+
+	if len(peers) != 1 {
+		return nil, fmt.Errorf("unexpected number of results from the Unifi Controller: %d", len(peers))
+	}
+	if peers[0].NetworkID == "" {
+		peers[0].NetworkID = peer.NetworkID
+	}
+
+	return &peers[0], nil
+}
+
+func (c *apiClient) deleteWireguardPeer(ctx context.Context, site, networkID, id string) error {
+	return c.do(ctx, http.MethodPost, wireguardPeersPath(site, networkID)+"/batch_delete", []string{id}, nil)
+}
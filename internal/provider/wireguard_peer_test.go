@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWireguardPeer_API(t *testing.T) {
+	ctx := context.Background()
+	var deleted []string
+	client := newAPITestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/s/default/rest/networkconf":
+			_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[{"_id":"lan","purpose":"corporate"},{"_id":"wg-1","purpose":"remote-user-vpn","vpn_type":"wireguard-server"},{"_id":"wg-2","purpose":"remote-user-vpn","vpn_type":"wireguard-server"}]}`))
+		case "GET /v2/api/site/default/wireguard/wg-1/users":
+			_, _ = w.Write([]byte(`[{"_id":"peer-1","name":"Laptop"}]`))
+		case "GET /v2/api/site/default/wireguard/wg-2/users":
+			_, _ = w.Write([]byte(`[{"_id":"peer-2","name":"Phone"}]`))
+		case "POST /v2/api/site/default/wireguard/wg-2/users/batch":
+			var peers []wireguardPeer
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&peers))
+			peers[0].ID = "peer-3"
+			_ = json.NewEncoder(w).Encode(peers)
+		case "POST /v2/api/site/default/wireguard/wg-2/users/batch_delete":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&deleted))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	// Without a server, e.g. after an import, every WireGuard server is
+	// searched for the peer.
+	peer, err := client.getWireguardPeer(ctx, "default", "", "peer-2")
+	assert.NoError(t, err)
+	assert.Equal(t, &wireguardPeer{ID: "peer-2", NetworkID: "wg-2", Name: "Phone"}, peer)
+
+	_, err = client.getWireguardPeer(ctx, "default", "wg-1", "peer-2")
+	assert.ErrorContains(t, err, "WireGuard peer peer-2 not found")
+
+	peer, err = client.createWireguardPeer(ctx, "default", &wireguardPeer{NetworkID: "wg-2", Name: "Tablet"})
+	assert.NoError(t, err)
+	assert.Equal(t, &wireguardPeer{ID: "peer-3", NetworkID: "wg-2", Name: "Tablet"}, peer)
+
+	assert.NoError(t, client.deleteWireguardPeer(ctx, "default", "wg-2", "peer-3"))
+	assert.Equal(t, []string{"peer-3"}, deleted)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateWireguardKey(t *testing.T) {
+	privateKey, publicKey, err := generateWireguardKey()
+	assert.NoError(t, err)
+	assert.Equal(t, 44, len(privateKey))
+
+	derived, err := wireguardPublicKey(privateKey)
+	assert.NoError(t, err)
+	assert.Equal(t, publicKey, derived)
+
+	_, err = wireguardPublicKey("not a key")
+	assert.Error(t, err)
+}
+
+func TestWireguardClientConfig(t *testing.T) {
+	server := networkConf{
+		IPSubnet:            "192.168.3.1/24",
+		LocalPort:           51820,
+		WireguardPublicKey:  "c2VydmVy",
+		WireguardLocalWANIP: "203.0.113.2",
+	}
+	peer := wireguardPeer{
+		InterfaceIP:  "192.168.3.2",
+		PresharedKey: "cHNr",
+	}
+
+	assert.Equal(t, `[Interface]
+PrivateKey = cGVlcg==
+Address = 192.168.3.2/32
+DNS = 192.168.3.1
+
+[Peer]
+PublicKey = c2VydmVy
+PresharedKey = cHNr
+AllowedIPs = 0.0.0.0/0, ::/0
+Endpoint = 203.0.113.2:51820
+`, wireguardClientConfig(peer, "cGVlcg==", server, "", nil))
+
+	server.DHCPDDNS1 = "1.1.1.1"
+	server.DHCPDDNS2 = "9.9.9.9"
+	peer.PresharedKey = ""
+	assert.Equal(t, `[Interface]
+Address = 192.168.3.2/32
+DNS = 1.1.1.1, 9.9.9.9
+
+[Peer]
+PublicKey = c2VydmVy
+AllowedIPs = 192.168.1.0/24, 192.168.20.0/24
+Endpoint = vpn.example.com:51820
+`, wireguardClientConfig(peer, "", server, "vpn.example.com", []string{"192.168.1.0/24", "192.168.20.0/24"}))
+}
@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/zoullx/terraform-provider-unifi/internal/resource_wlan"
@@ -15,13 +14,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/zoullx/unifi-go/unifi"
 )
 
 var (
-	_ resource.Resource                = &wlanResource{}
-	_ resource.ResourceWithConfigure   = &wlanResource{}
-	_ resource.ResourceWithImportState = &wlanResource{}
+	_ resource.Resource                 = &wlanResource{}
+	_ resource.ResourceWithConfigure    = &wlanResource{}
+	_ resource.ResourceWithImportState  = &wlanResource{}
+	_ resource.ResourceWithUpgradeState = &wlanResource{}
+	_ resource.ResourceWithModifyPlan   = &wlanResource{}
 )
 
 func NewWlanResource() resource.Resource {
@@ -38,6 +40,11 @@ func (r *wlanResource) Metadata(ctx context.Context, req resource.MetadataReques
 
 func (r *wlanResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_wlan.WlanResourceSchema(ctx)
+	resp.Schema.Version = setSchemaVersion
+}
+
+func (r *wlanResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return listToSetStateUpgraders(ctx, wlanSchemaV0(), resource_wlan.WlanResourceSchema(ctx))
 }
 
 func (r *wlanResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -62,9 +69,8 @@ func (r *wlanResource) Configure(ctx context.Context, req resource.ConfigureRequ
 }
 
 func (r *wlanResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	site, id, ok := parseImportID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
 			fmt.Sprintf("Expected import identifier with format: site/id. Got: %q", req.ID),
@@ -72,11 +78,63 @@ func (r *wlanResource) ImportState(ctx context.Context, req resource.ImportState
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site"), site)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *wlanResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Refuse to plan the destroy of a protected WLAN.
+	if req.Plan.Raw.IsNull() {
+		var prior resource_wlan.WlanModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+		resp.Diagnostics.Append(checkDeletionProtection(prior.DeletionProtection, "WLAN")...)
+		return
+	}
+
+	// Nothing more to check when the provider hasn't been configured yet.
+	if r.client == nil {
+		return
+	}
+
+	var mloEnabled types.Bool
+	var site types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("mlo_enabled"), &mloEnabled)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("site"), &site)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if mloEnabled.ValueBool() {
+		resp.Diagnostics.Append(requireControllerCapability(ctx, r.client, site.ValueString(), capabilityWifi7Mlo, path.Root("mlo_enabled"))...)
+	}
+
+	var planned, prior resource_wlan.WlanModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planned)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	}
+	if resp.Diagnostics.HasError() || planned.Site.IsUnknown() || !wlanConflictsChanged(planned, prior) {
+		return
+	}
+
+	// Look for other WLANs broadcasting the same SSID.
+	wlans, err := r.client.ListWLAN(ctx, planned.Site.ValueString())
+	if err != nil {
+		tflog.Warn(ctx, "Unable to list WLANs, skipping conflict detection", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.Diagnostics.Append(wlanConflicts(planned, prior, wlans)...)
 }
 
 func (r *wlanResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "create WLAN")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_wlan.WlanModel
 
 	// Read Terraform plan data into the model
@@ -101,6 +159,8 @@ func (r *wlanResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *wlan)...)
+
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	// Save data into Terraform state
@@ -131,11 +191,20 @@ func (r *wlanResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *wlan)...)
+
+	data.DeletionProtection = deletionProtectionValue(data.DeletionProtection)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *wlanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "update WLAN")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_wlan.WlanModel
 
 	// Read Terraform plan data into the model
@@ -144,7 +213,15 @@ func (r *wlanResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	var body unifi.WLAN
+	current, diags := readForUpdate(ctx, r.client, req.Private, "WLAN", func() (*unifi.WLAN, error) {
+		return r.client.GetWLAN(ctx, data.Site.ValueString(), data.Id.ValueString())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := *current
 	resp.Diagnostics.Append(parseWlanResourceModel(ctx, data, &body)...)
 	wlan, err := r.client.UpdateWLAN(ctx, data.Site.ValueString(), &body)
 	if err != nil {
@@ -160,6 +237,8 @@ func (r *wlanResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(setFingerprint(ctx, resp.Private, *wlan)...)
+
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	// Save updated data into Terraform state
@@ -167,6 +246,11 @@ func (r *wlanResource) Update(ctx context.Context, req resource.UpdateRequest, r
 }
 
 func (r *wlanResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.client, "delete WLAN")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data resource_wlan.WlanModel
 
 	// Read Terraform prior state data into the model
@@ -175,6 +259,11 @@ func (r *wlanResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(checkDeletionProtection(data.DeletionProtection, "WLAN")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete existing WLAN
 	err := r.client.DeleteWLAN(ctx, data.Site.ValueString(), data.Id.ValueString())
 	if err != nil {
@@ -187,63 +276,10 @@ func (r *wlanResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func parseWlanResourceJson(ctx context.Context, json unifi.WLAN, model *resource_wlan.WlanModel) diag.Diagnostics {
-	model.Id = types.StringValue(json.ID)
-	model.SiteId = types.StringValue(json.SiteID)
-
-	apGroupIdList, diags := types.ListValueFrom(ctx, types.StringType, json.ApGroupIDs)
+	diags := mapWlanResourceJson(ctx, json, model)
 	if diags.HasError() {
 		return diags
 	}
-	model.ApGroupIds = apGroupIdList
-
-	model.ApGroupMode = types.StringValue(json.ApGroupMode)
-	model.BSupported = types.BoolValue(json.BSupported)
-
-	broadcastFilterList, diags := types.ListValueFrom(ctx, types.StringType, json.BroadcastFilterList)
-	if diags.HasError() {
-		return diags
-	}
-	model.BroadcastFilterList = broadcastFilterList
-
-	model.BssTransition = types.BoolValue(json.BssTransition)
-	model.Dtim6e = types.Int64Value(int64(json.DTIM6E))
-	model.Dtim2g = types.Int64Value(int64(json.DTIMNg))
-	model.Dtim5g = types.Int64Value(int64(json.DTIMNa))
-	model.DtimMode = types.StringValue(json.DTIMMode)
-	model.Enabled = types.BoolValue(json.Enabled)
-	model.EnhancedIot = types.BoolValue(json.EnhancedIot)
-	model.FastRoamingEnabled = types.BoolValue(json.FastRoamingEnabled)
-	model.GroupRekey = types.Int64Value(int64(json.GroupRekey))
-	model.HideSsid = types.BoolValue(json.HideSSID)
-	model.Hotspot2confEnabled = types.BoolValue(json.Hotspot2ConfEnabled)
-	model.IsGuest = types.BoolValue(json.IsGuest)
-	model.IappEnabled = types.BoolValue(json.IappEnabled)
-	model.IappKey = types.StringValue(json.XIappKey)
-	model.L2Isolation = types.BoolValue(json.L2Isolation)
-	model.MacFilterEnabled = types.BoolValue(json.MACFilterEnabled)
-
-	model.MacFilterList, diags = types.ListValueFrom(ctx, types.StringType, json.MACFilterList)
-	if diags.HasError() {
-		return diags
-	}
-
-	model.MacFilterPolicy = types.StringValue(json.MACFilterPolicy)
-	model.Minimum2gAdvertisingRates = types.BoolValue(json.MinrateNgAdvertisingRates)
-	model.Minimum2gDataRateEnabled = types.BoolValue(json.MinrateNgEnabled)
-	model.Minimum2gDataRateKbps = types.Int64Value(int64(json.MinrateNgDataRateKbps))
-	model.Minimum5gAdvertisingRates = types.BoolValue(json.MinrateNaAdvertisingRates)
-	model.Minimum5gDataRateEnabled = types.BoolValue(json.MinrateNaEnabled)
-	model.Minimum5gDataRateKbps = types.Int64Value(int64(json.MinrateNaDataRateKbps))
-	model.MinimumDataRateSettingPreference = types.StringValue(json.MinrateSettingPreference)
-	model.MloEnabled = types.BoolValue(json.MloEnabled)
-	model.MulticastEnhanceEnabled = types.BoolValue(json.MulticastEnhanceEnabled)
-	model.Name = types.StringValue(json.Name)
-	model.NetworkId = types.StringValue(json.NetworkID)
-	model.No2ghzOui = types.BoolValue(json.No2GhzOui)
-	model.OptimizeIotWifiConnectivity = types.BoolValue(json.OptimizeIotWifiConnectivity)
-	model.Passphrase = types.StringValue(json.XPassphrase)
-	model.PassphraseAutogenerated = types.BoolValue(json.PassphraseAutogenerated)
-	model.PmfMode = types.StringValue(json.PMFMode)
 
 	privatePresharedKeyList, diags := types.ListValueFrom(ctx, resource_wlan.PrivatePresharedKeysValue{}.Type(ctx), json.PrivatePresharedKeys)
 	if diags.HasError() {
@@ -251,19 +287,6 @@ func parseWlanResourceJson(ctx context.Context, json unifi.WLAN, model *resource
 	}
 	model.PrivatePresharedKeys = privatePresharedKeyList
 
-	model.PrivatePresharedKeysEnabled = types.BoolValue(json.PrivatePresharedKeysEnabled)
-	model.ProxyArp = types.BoolValue(json.ProxyArp)
-	model.RadiusDasEnabled = types.BoolValue(json.RADIUSDasEnabled)
-	model.RadiusMacAuthEnabled = types.BoolValue(json.RADIUSMACAuthEnabled)
-	model.RadiusMacAclFormat = types.StringValue(json.RADIUSMACaclFormat)
-	model.RadiusProfileId = types.StringValue(json.RADIUSProfileID)
-
-	saeGroupList, diags := types.ListValueFrom(ctx, types.Int64Type, json.SaeGroups)
-	if diags.HasError() {
-		return diags
-	}
-	model.SaeGroups = saeGroupList
-
 	saePskList, diags := types.ListValueFrom(ctx, resource_wlan.SaePsksValue{}.Type(ctx), json.SaePsk)
 	if diags.HasError() {
 		return diags
@@ -275,91 +298,15 @@ func parseWlanResourceJson(ctx context.Context, json unifi.WLAN, model *resource
 		return diags
 	}
 
-	model.Security = types.StringValue(json.Security)
-	model.SettingPreference = types.StringValue(json.SettingPreference)
-	model.UapsdEnabled = types.BoolValue(json.UapsdEnabled)
-	model.UserGroupId = types.StringValue(json.UserGroupID)
-	model.WlanBand = types.StringValue(json.WLANBand)
-
-	wlanBandList, diags := types.ListValueFrom(ctx, types.StringType, json.WLANBands)
-	if diags.HasError() {
-		return diags
-	}
-	model.WlanBands = wlanBandList
-
-	model.WpaEnc = types.StringValue(json.WPAEnc)
-	model.WpaMode = types.StringValue(json.WPAMode)
-	model.Wpa3Enhanced192 = types.BoolValue(json.WPA3Enhanced192)
-	model.Wpa3FastRoaming = types.BoolValue(json.WPA3FastRoaming)
-	model.Wpa3Support = types.BoolValue(json.WPA3Support)
-	model.Wpa3Transition = types.BoolValue(json.WPA3Transition)
-
 	return nil
 }
 
 func parseWlanResourceModel(ctx context.Context, model resource_wlan.WlanModel, json *unifi.WLAN) diag.Diagnostics {
-	json.ID = model.Id.ValueString()
-	json.SiteID = model.SiteId.ValueString()
-
-	if !model.ApGroupIds.IsUnknown() && !model.ApGroupIds.IsNull() {
-		diags := model.ApGroupIds.ElementsAs(ctx, &json.ApGroupIDs, false)
-		if diags.HasError() {
-			return diags
-		}
-	}
-
-	json.ApGroupMode = model.ApGroupMode.ValueString()
-	json.BSupported = model.BSupported.ValueBool()
-
-	if !model.BroadcastFilterList.IsUnknown() && !model.BroadcastFilterList.IsNull() {
-		diags := model.BroadcastFilterList.ElementsAs(ctx, &json.BroadcastFilterList, false)
-		if diags.HasError() {
-			return diags
-		}
-	}
-
-	json.BssTransition = model.BssTransition.ValueBool()
-	json.DTIM6E = int(model.Dtim6e.ValueInt64())
-	json.DTIMNg = int(model.Dtim2g.ValueInt64())
-	json.DTIMNa = int(model.Dtim5g.ValueInt64())
-	json.DTIMMode = model.DtimMode.ValueString()
-	json.Enabled = model.Enabled.ValueBool()
-	json.EnhancedIot = model.EnhancedIot.ValueBool()
-	json.FastRoamingEnabled = model.FastRoamingEnabled.ValueBool()
-	json.GroupRekey = int(model.GroupRekey.ValueInt64())
-	json.HideSSID = model.HideSsid.ValueBool()
-	json.Hotspot2ConfEnabled = model.Hotspot2confEnabled.ValueBool()
-	json.IsGuest = model.IsGuest.ValueBool()
-	json.IappEnabled = model.IappEnabled.ValueBool()
-	json.XIappKey = model.IappKey.ValueString()
-	json.L2Isolation = model.L2Isolation.ValueBool()
-	json.MACFilterEnabled = model.MacFilterEnabled.ValueBool()
-
-	if !model.MacFilterList.IsUnknown() && !model.MacFilterList.IsNull() {
-		diags := model.MacFilterList.ElementsAs(ctx, &json.MACFilterList, false)
-		if diags.HasError() {
-			return diags
-		}
+	diags := mapWlanResourceModel(ctx, model, json)
+	if diags.HasError() {
+		return diags
 	}
 
-	json.MACFilterPolicy = model.MacFilterPolicy.ValueString()
-	json.MinrateNgAdvertisingRates = model.Minimum2gAdvertisingRates.ValueBool()
-	json.MinrateNgEnabled = model.Minimum2gDataRateEnabled.ValueBool()
-	json.MinrateNgDataRateKbps = int(model.Minimum2gDataRateKbps.ValueInt64())
-	json.MinrateNaAdvertisingRates = model.Minimum5gAdvertisingRates.ValueBool()
-	json.MinrateNaEnabled = model.Minimum5gDataRateEnabled.ValueBool()
-	json.MinrateNaDataRateKbps = int(model.Minimum5gDataRateKbps.ValueInt64())
-	json.MinrateSettingPreference = model.MinimumDataRateSettingPreference.ValueString()
-	json.MloEnabled = model.MloEnabled.ValueBool()
-	json.MulticastEnhanceEnabled = model.MulticastEnhanceEnabled.ValueBool()
-	json.Name = model.Name.ValueString()
-	json.NetworkID = model.NetworkId.ValueString()
-	json.No2GhzOui = model.No2ghzOui.ValueBool()
-	json.OptimizeIotWifiConnectivity = model.OptimizeIotWifiConnectivity.ValueBool()
-	json.XPassphrase = model.Passphrase.ValueString()
-	json.PassphraseAutogenerated = model.PassphraseAutogenerated.ValueBool()
-	json.PMFMode = model.PmfMode.ValueString()
-
 	if !model.PrivatePresharedKeys.IsUnknown() && !model.PrivatePresharedKeys.IsNull() {
 		diags := model.PrivatePresharedKeys.ElementsAs(ctx, &json.PrivatePresharedKeys, false)
 		if diags.HasError() {
@@ -367,20 +314,6 @@ func parseWlanResourceModel(ctx context.Context, model resource_wlan.WlanModel,
 		}
 	}
 
-	json.PrivatePresharedKeysEnabled = model.PrivatePresharedKeysEnabled.ValueBool()
-	json.ProxyArp = model.ProxyArp.ValueBool()
-	json.RADIUSDasEnabled = model.RadiusDasEnabled.ValueBool()
-	json.RADIUSMACAuthEnabled = model.RadiusMacAuthEnabled.ValueBool()
-	json.RADIUSMACaclFormat = model.RadiusMacAclFormat.ValueString()
-	json.RADIUSProfileID = model.RadiusProfileId.ValueString()
-
-	if !model.SaeGroups.IsUnknown() && !model.SaeGroups.IsNull() {
-		diags := model.SaeGroups.ElementsAs(ctx, &json.SaeGroups, false)
-		if diags.HasError() {
-			return diags
-		}
-	}
-
 	if !model.SaePsks.IsUnknown() && !model.SaePsks.IsNull() {
 		diags := model.SaePsks.ElementsAs(ctx, &json.SaePsk, false)
 		if diags.HasError() {
@@ -395,25 +328,5 @@ func parseWlanResourceModel(ctx context.Context, model resource_wlan.WlanModel,
 		}
 	}
 
-	json.Security = model.Security.ValueString()
-	json.SettingPreference = model.SettingPreference.ValueString()
-	json.UapsdEnabled = model.UapsdEnabled.ValueBool()
-	json.UserGroupID = model.UserGroupId.ValueString()
-	json.WLANBand = model.WlanBand.ValueString()
-
-	if !model.WlanBands.IsUnknown() && !model.WlanBands.IsNull() {
-		diags := model.WlanBands.ElementsAs(ctx, &json.WLANBands, false)
-		if diags.HasError() {
-			return diags
-		}
-	}
-
-	json.WPAEnc = model.WpaEnc.ValueString()
-	json.WPAMode = model.WpaMode.ValueString()
-	json.WPA3Enhanced192 = model.Wpa3Enhanced192.ValueBool()
-	json.WPA3FastRoaming = model.Wpa3FastRoaming.ValueBool()
-	json.WPA3Support = model.Wpa3Support.ValueBool()
-	json.WPA3Transition = model.Wpa3Transition.ValueBool()
-
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/zoullx/terraform-provider-unifi/internal/datasource_wlans"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -84,7 +85,7 @@ func (d *wlansDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 }
 
 func parseWlansDataSourceJson(ctx context.Context, json []unifi.WLAN, model *datasource_wlans.WlansModel) diag.Diagnostics {
-	wlanList, diags := types.ListValueFrom(ctx, datasource_wlans.WlansValue{}.Type(ctx), json)
+	wlanList, diags := objectListValue(ctx, datasource_wlans.WlansValue{}.Type(ctx), json, parseWlansDataSourceItemJson)
 	if diags.HasError() {
 		return diags
 	}
@@ -92,3 +93,21 @@ func parseWlansDataSourceJson(ctx context.Context, json []unifi.WLAN, model *dat
 
 	return nil
 }
+
+func parseWlansDataSourceItemJson(ctx context.Context, json unifi.WLAN, attributes map[string]attr.Value) diag.Diagnostics {
+	diags := mapWlansDataSourceJson(ctx, json, attributes)
+
+	privatePresharedKeyList, d := types.ListValueFrom(ctx, datasource_wlans.PrivatePresharedKeysValue{}.Type(ctx), json.PrivatePresharedKeys)
+	diags.Append(d...)
+	attributes["private_preshared_keys"] = privatePresharedKeyList
+
+	saePskList, d := types.ListValueFrom(ctx, datasource_wlans.SaePsksValue{}.Type(ctx), json.SaePsk)
+	diags.Append(d...)
+	attributes["sae_psks"] = saePskList
+
+	schedule, d := types.ListValueFrom(ctx, datasource_wlans.ScheduleValue{}.Type(ctx), json.ScheduleWithDuration)
+	diags.Append(d...)
+	attributes["schedule"] = schedule
+
+	return diags
+}
@@ -4,7 +4,11 @@ package provider_unifi
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 )
@@ -23,17 +27,120 @@ func UnifiProviderSchema(ctx context.Context) schema.Schema {
 				Description:         "The API Key to use to connect to the Unifi Controller.",
 				MarkdownDescription: "The API Key to use to connect to the Unifi Controller.",
 			},
+			"ca_certificate": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A PEM encoded CA certificate bundle, or a path to a file containing one, used to verify the certificate of the Unifi Controller in addition to the system trust store. Can also be set with the UNIFI_CA_CERTIFICATE environment variable.",
+				MarkdownDescription: "A PEM encoded CA certificate bundle, or a path to a file containing one, used to verify the certificate of the Unifi Controller in addition to the system trust store. Can also be set with the UNIFI_CA_CERTIFICATE environment variable.",
+			},
+			"client_certificate": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A PEM encoded client certificate, or a path to a file containing one, used for mutual TLS authentication with the Unifi Controller. Requires `client_key`. Can also be set with the UNIFI_CLIENT_CERTIFICATE environment variable.",
+				MarkdownDescription: "A PEM encoded client certificate, or a path to a file containing one, used for mutual TLS authentication with the Unifi Controller. Requires `client_key`. Can also be set with the UNIFI_CLIENT_CERTIFICATE environment variable.",
+			},
+			"client_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				Description:         "A PEM encoded private key for `client_certificate`, or a path to a file containing one. Can also be set with the UNIFI_CLIENT_KEY environment variable.",
+				MarkdownDescription: "A PEM encoded private key for `client_certificate`, or a path to a file containing one. Can also be set with the UNIFI_CLIENT_KEY environment variable.",
+			},
+			"connect_timeout": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The maximum number of seconds to wait for a connection to the Unifi Controller to be established. Defaults to 30. Can also be set with the UNIFI_CONNECT_TIMEOUT environment variable.",
+				MarkdownDescription: "The maximum number of seconds to wait for a connection to the Unifi Controller to be established. Defaults to 30. Can also be set with the UNIFI_CONNECT_TIMEOUT environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"force_overwrite": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Update networks and WLANs even when they were modified outside Terraform, e.g. in the Unifi UI, since the plan was created. By default such updates fail so the other change isn't silently overwritten. Only changes to settings known to the provider are detected, changes to other settings of the object are not. Can also be set with the UNIFI_FORCE_OVERWRITE environment variable.",
+				MarkdownDescription: "Update networks and WLANs even when they were modified outside Terraform, e.g. in the Unifi UI, since the plan was created. By default such updates fail so the other change isn't silently overwritten. Only changes to settings known to the provider are detected, changes to other settings of the object are not. Can also be set with the UNIFI_FORCE_OVERWRITE environment variable.",
+			},
+			"headers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				Description:         "Additional HTTP headers to send with every request to the Unifi Controller, e.g. for authenticating against a reverse proxy.",
+				MarkdownDescription: "Additional HTTP headers to send with every request to the Unifi Controller, e.g. for authenticating against a reverse proxy.",
+			},
 			"host": schema.StringAttribute{
 				Required:            true,
 				Description:         "The host address of the Unifi Controller.",
 				MarkdownDescription: "The host address of the Unifi Controller.",
 			},
+			"http_proxy": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The URL of an HTTP proxy to use for requests to the Unifi Controller. Can also be set with the UNIFI_HTTP_PROXY environment variable. When not set, the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used.",
+				MarkdownDescription: "The URL of an HTTP proxy to use for requests to the Unifi Controller. Can also be set with the UNIFI_HTTP_PROXY environment variable. When not set, the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used.",
+			},
+			"lockout_guard": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Refuse plans that could cut off the Unifi Controller or the machine running Terraform from the network: disabling, deleting or moving the network containing their IP address, or dropping or rejecting traffic from it to the gateway in the `*_LOCAL` firewall rulesets, or to another protected address in the `LAN_IN` ruleset. The controller address and the local address used to reach it are protected automatically, see also `management_addresses`. Can also be set with the UNIFI_LOCKOUT_GUARD environment variable, and skipped for a single run by setting the UNIFI_ALLOW_LOCKOUT environment variable to `true`.",
+				MarkdownDescription: "Refuse plans that could cut off the Unifi Controller or the machine running Terraform from the network: disabling, deleting or moving the network containing their IP address, or dropping or rejecting traffic from it to the gateway in the `*_LOCAL` firewall rulesets, or to another protected address in the `LAN_IN` ruleset. The controller address and the local address used to reach it are protected automatically, see also `management_addresses`. Can also be set with the UNIFI_LOCKOUT_GUARD environment variable, and skipped for a single run by setting the UNIFI_ALLOW_LOCKOUT environment variable to `true`.",
+			},
+			"management_addresses": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Additional IP addresses protected by `lockout_guard`, e.g. the address of a jump host or of the Terraform runner when it is behind NAT.",
+				MarkdownDescription: "Additional IP addresses protected by `lockout_guard`, e.g. the address of a jump host or of the Terraform runner when it is behind NAT.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.IPAddress()),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The maximum number of times a request to the Unifi Controller is retried after a transient error, such as a 5xx response, a connection reset or the controller being busy. Set to 0 to disable retries. Defaults to 3. Can also be set with the UNIFI_MAX_RETRIES environment variable.",
+				MarkdownDescription: "The maximum number of times a request to the Unifi Controller is retried after a transient error, such as a 5xx response, a connection reset or the controller being busy. Set to 0 to disable retries. Defaults to 3. Can also be set with the UNIFI_MAX_RETRIES environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_retry_wait": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The maximum number of seconds to wait between retries of a request to the Unifi Controller. Defaults to 30. Can also be set with the UNIFI_MAX_RETRY_WAIT environment variable.",
+				MarkdownDescription: "The maximum number of seconds to wait between retries of a request to the Unifi Controller. Defaults to 30. Can also be set with the UNIFI_MAX_RETRY_WAIT environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Run the provider in read-only mode. Every create, update and delete fails before any request is sent to the Unifi Controller, while reads and data sources keep working. Useful for audit pipelines that only run `terraform plan`. Can also be set with the UNIFI_READ_ONLY environment variable.",
+				MarkdownDescription: "Run the provider in read-only mode. Every create, update and delete fails before any request is sent to the Unifi Controller, while reads and data sources keep working. Useful for audit pipelines that only run `terraform plan`. Can also be set with the UNIFI_READ_ONLY environment variable.",
+			},
+			"request_timeout": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The maximum number of seconds to wait for the Unifi Controller to respond to a request. Defaults to no timeout. Can also be set with the UNIFI_REQUEST_TIMEOUT environment variable.",
+				MarkdownDescription: "The maximum number of seconds to wait for the Unifi Controller to respond to a request. Defaults to no timeout. Can also be set with the UNIFI_REQUEST_TIMEOUT environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"tls_server_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The server name used to verify the certificate of the Unifi Controller, when it differs from the host address. Can also be set with the UNIFI_TLS_SERVER_NAME environment variable.",
+				MarkdownDescription: "The server name used to verify the certificate of the Unifi Controller, when it differs from the host address. Can also be set with the UNIFI_TLS_SERVER_NAME environment variable.",
+			},
 		},
 	}
 }
 
 type UnifiModel struct {
-	AllowInsecure types.Bool   `tfsdk:"allow_insecure"`
-	ApiKey        types.String `tfsdk:"api_key"`
-	Host          types.String `tfsdk:"host"`
+	AllowInsecure       types.Bool   `tfsdk:"allow_insecure"`
+	ApiKey              types.String `tfsdk:"api_key"`
+	CaCertificate       types.String `tfsdk:"ca_certificate"`
+	ClientCertificate   types.String `tfsdk:"client_certificate"`
+	ClientKey           types.String `tfsdk:"client_key"`
+	ConnectTimeout      types.Int64  `tfsdk:"connect_timeout"`
+	ForceOverwrite      types.Bool   `tfsdk:"force_overwrite"`
+	Headers             types.Map    `tfsdk:"headers"`
+	Host                types.String `tfsdk:"host"`
+	HttpProxy           types.String `tfsdk:"http_proxy"`
+	LockoutGuard        types.Bool   `tfsdk:"lockout_guard"`
+	ManagementAddresses types.List   `tfsdk:"management_addresses"`
+	MaxRetries          types.Int64  `tfsdk:"max_retries"`
+	MaxRetryWait        types.Int64  `tfsdk:"max_retry_wait"`
+	ReadOnly            types.Bool   `tfsdk:"read_only"`
+	RequestTimeout      types.Int64  `tfsdk:"request_timeout"`
+	TlsServerName       types.String `tfsdk:"tls_server_name"`
 }
@@ -4,9 +4,13 @@ package resource_ap_group
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
@@ -14,11 +18,14 @@ import (
 func ApGroupResourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"device_macs": schema.ListAttribute{
-				ElementType:         types.StringType,
+			"device_macs": schema.SetAttribute{
+				ElementType:         customtypes.MacAddressType{},
 				Required:            true,
 				Description:         "The MAC addresses of the APs associated with this AP Group.",
 				MarkdownDescription: "The MAC addresses of the APs associated with this AP Group.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.MACAddress()),
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -48,7 +55,7 @@ func ApGroupResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type ApGroupModel struct {
-	DeviceMacs  types.List   `tfsdk:"device_macs"`
+	DeviceMacs  types.Set    `tfsdk:"device_macs"`
 	Id          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
 	Name        types.String `tfsdk:"name"`
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -31,6 +32,13 @@ func DeviceResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Specifies whether this resource should tell the controller to adopt the device on create.",
 				Default:             booldefault.StaticBool(true),
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether Terraform is prevented from deleting the Device. Set it to `false` and apply the change before destroying the Device. Defaults to `false`.",
+				MarkdownDescription: "Whether Terraform is prevented from deleting the Device. Set it to `false` and apply the change before destroying the Device. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
 			"disabled": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -58,6 +66,7 @@ func DeviceResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Timestamp of the last Terraform update of the Device.",
 			},
 			"mac": schema.StringAttribute{
+				CustomType:          customtypes.MacAddressType{},
 				Optional:            true,
 				Computed:            true,
 				Description:         "The MAC address of the Device. This can be specified so that the provider can take control of a device (since devices are created through adoption).",
@@ -146,16 +155,17 @@ func DeviceResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type DeviceModel struct {
-	AllowAdoption   types.Bool   `tfsdk:"allow_adoption"`
-	Disabled        types.Bool   `tfsdk:"disabled"`
-	ForgetOnDestroy types.Bool   `tfsdk:"forget_on_destroy"`
-	Id              types.String `tfsdk:"id"`
-	LastUpdated     types.String `tfsdk:"last_updated"`
-	Mac             types.String `tfsdk:"mac"`
-	Name            types.String `tfsdk:"name"`
-	PortOverrides   types.List   `tfsdk:"port_overrides"`
-	Site            types.String `tfsdk:"site"`
-	SiteId          types.String `tfsdk:"site_id"`
+	AllowAdoption      types.Bool             `tfsdk:"allow_adoption"`
+	DeletionProtection types.Bool             `tfsdk:"deletion_protection"`
+	Disabled           types.Bool             `tfsdk:"disabled"`
+	ForgetOnDestroy    types.Bool             `tfsdk:"forget_on_destroy"`
+	Id                 types.String           `tfsdk:"id"`
+	LastUpdated        types.String           `tfsdk:"last_updated"`
+	Mac                customtypes.MacAddress `tfsdk:"mac"`
+	Name               types.String           `tfsdk:"name"`
+	PortOverrides      types.List             `tfsdk:"port_overrides"`
+	Site               types.String           `tfsdk:"site"`
+	SiteId             types.String           `tfsdk:"site_id"`
 }
 
 var _ basetypes.ObjectTypable = PortOverridesType{}
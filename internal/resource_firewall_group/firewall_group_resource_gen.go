@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
@@ -29,8 +30,8 @@ func FirewallGroupResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Timestamp of the last Terraform update of the Firewall Group.",
 				MarkdownDescription: "Timestamp of the last Terraform update of the Firewall Group.",
 			},
-			"members": schema.ListAttribute{
-				ElementType:         types.StringType,
+			"members": schema.SetAttribute{
+				ElementType:         customtypes.IPAddressOrPrefixType{},
 				Optional:            true,
 				Computed:            true,
 				Description:         "The members of the Firewall Group.",
@@ -70,7 +71,7 @@ func FirewallGroupResourceSchema(ctx context.Context) schema.Schema {
 type FirewallGroupModel struct {
 	Id          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
-	Members     types.List   `tfsdk:"members"`
+	Members     types.Set    `tfsdk:"members"`
 	Name        types.String `tfsdk:"name"`
 	Site        types.String `tfsdk:"site"`
 	SiteId      types.String `tfsdk:"site_id"`
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
@@ -31,14 +33,20 @@ func FirewallRuleResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The destination address of the Firewall Rule.",
 				MarkdownDescription: "The destination address of the Firewall Rule.",
+				Validators: []validator.String{
+					validators.IPv4AddressOrCIDR(),
+				},
 			},
 			"dst_address_ipv6": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "The IPv6 destination address of the Firewall Rule.",
 				MarkdownDescription: "The IPv6 destination address of the Firewall Rule.",
+				Validators: []validator.String{
+					validators.IPv6AddressOrCIDR(),
+				},
 			},
-			"dst_firewall_group_ids": schema.ListAttribute{
+			"dst_firewall_group_ids": schema.SetAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
 				Computed:            true,
@@ -65,6 +73,9 @@ func FirewallRuleResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The destination port for the Firewall Rule.",
 				MarkdownDescription: "The destination port for the Firewall Rule.",
+				Validators: []validator.String{
+					validators.PortSpec(),
+				},
 			},
 			"enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -77,12 +88,18 @@ func FirewallRuleResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "ICMP type name.",
 				MarkdownDescription: "ICMP type name.",
+				Validators: []validator.String{
+					validators.ICMPTypeName(),
+				},
 			},
 			"icmp_v6_typename": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "ICMPv6 type name.",
 				MarkdownDescription: "ICMPv6 type name.",
+				Validators: []validator.String{
+					validators.ICMPv6TypeName(),
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -122,6 +139,9 @@ func FirewallRuleResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The protocol of the Firewall Rule.",
 				MarkdownDescription: "The protocol of the Firewall Rule.",
+				Validators: []validator.String{
+					validators.FirewallProtocol(),
+				},
 			},
 			"protocol_match_excepted": schema.BoolAttribute{
 				Optional:            true,
@@ -134,6 +154,9 @@ func FirewallRuleResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The IPv6 protocol of the Firewall Rule.",
 				MarkdownDescription: "The IPv6 protocol of the Firewall Rule.",
+				Validators: []validator.String{
+					validators.FirewallProtocolV6(),
+				},
 			},
 			"rule_index": schema.Int64Attribute{
 				Optional:            true,
@@ -180,14 +203,20 @@ func FirewallRuleResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The source address for the Firewall Rule.",
 				MarkdownDescription: "The source address for the Firewall Rule.",
+				Validators: []validator.String{
+					validators.IPv4AddressOrCIDR(),
+				},
 			},
 			"src_address_ipv6": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "The IPv6 source address for the Firewall Rule.",
 				MarkdownDescription: "The IPv6 source address for the Firewall Rule.",
+				Validators: []validator.String{
+					validators.IPv6AddressOrCIDR(),
+				},
 			},
-			"src_firewall_group_ids": schema.ListAttribute{
+			"src_firewall_group_ids": schema.SetAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
 				Computed:            true,
@@ -195,6 +224,7 @@ func FirewallRuleResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The source Firewall Group IDs for the Firewall Rule.",
 			},
 			"src_mac": schema.StringAttribute{
+				CustomType:          customtypes.MacAddressType{},
 				Optional:            true,
 				Computed:            true,
 				Description:         "The source MAC address of the Firewall Rule.",
@@ -220,6 +250,9 @@ func FirewallRuleResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The source port of the Firewall Rule.",
 				MarkdownDescription: "The source port of the Firewall Rule.",
+				Validators: []validator.String{
+					validators.PortSpec(),
+				},
 			},
 			"state_established": schema.BoolAttribute{
 				Optional:            true,
@@ -250,38 +283,38 @@ func FirewallRuleResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type FirewallRuleModel struct {
-	Action                types.String `tfsdk:"action"`
-	DstAddress            types.String `tfsdk:"dst_address"`
-	DstAddressIpv6        types.String `tfsdk:"dst_address_ipv6"`
-	DstFirewallGroupIds   types.List   `tfsdk:"dst_firewall_group_ids"`
-	DstNetworkId          types.String `tfsdk:"dst_network_id"`
-	DstNetworkType        types.String `tfsdk:"dst_network_type"`
-	DstPort               types.String `tfsdk:"dst_port"`
-	Enabled               types.Bool   `tfsdk:"enabled"`
-	IcmpTypename          types.String `tfsdk:"icmp_typename"`
-	IcmpV6Typename        types.String `tfsdk:"icmp_v6_typename"`
-	Id                    types.String `tfsdk:"id"`
-	IpSec                 types.String `tfsdk:"ip_sec"`
-	LastUpdated           types.String `tfsdk:"last_updated"`
-	Logging               types.Bool   `tfsdk:"logging"`
-	Name                  types.String `tfsdk:"name"`
-	Protocol              types.String `tfsdk:"protocol"`
-	ProtocolMatchExcepted types.Bool   `tfsdk:"protocol_match_excepted"`
-	ProtocolV6            types.String `tfsdk:"protocol_v6"`
-	RuleIndex             types.Int64  `tfsdk:"rule_index"`
-	Ruleset               types.String `tfsdk:"ruleset"`
-	SettingPreference     types.String `tfsdk:"setting_preference"`
-	Site                  types.String `tfsdk:"site"`
-	SiteId                types.String `tfsdk:"site_id"`
-	SrcAddress            types.String `tfsdk:"src_address"`
-	SrcAddressIpv6        types.String `tfsdk:"src_address_ipv6"`
-	SrcFirewallGroupIds   types.List   `tfsdk:"src_firewall_group_ids"`
-	SrcMac                types.String `tfsdk:"src_mac"`
-	SrcNetworkId          types.String `tfsdk:"src_network_id"`
-	SrcNetworkType        types.String `tfsdk:"src_network_type"`
-	SrcPort               types.String `tfsdk:"src_port"`
-	StateEstablished      types.Bool   `tfsdk:"state_established"`
-	StateInvalid          types.Bool   `tfsdk:"state_invalid"`
-	StateNew              types.Bool   `tfsdk:"state_new"`
-	StateRelated          types.Bool   `tfsdk:"state_related"`
+	Action                types.String           `tfsdk:"action"`
+	DstAddress            types.String           `tfsdk:"dst_address"`
+	DstAddressIpv6        types.String           `tfsdk:"dst_address_ipv6"`
+	DstFirewallGroupIds   types.Set              `tfsdk:"dst_firewall_group_ids"`
+	DstNetworkId          types.String           `tfsdk:"dst_network_id"`
+	DstNetworkType        types.String           `tfsdk:"dst_network_type"`
+	DstPort               types.String           `tfsdk:"dst_port"`
+	Enabled               types.Bool             `tfsdk:"enabled"`
+	IcmpTypename          types.String           `tfsdk:"icmp_typename"`
+	IcmpV6Typename        types.String           `tfsdk:"icmp_v6_typename"`
+	Id                    types.String           `tfsdk:"id"`
+	IpSec                 types.String           `tfsdk:"ip_sec"`
+	LastUpdated           types.String           `tfsdk:"last_updated"`
+	Logging               types.Bool             `tfsdk:"logging"`
+	Name                  types.String           `tfsdk:"name"`
+	Protocol              types.String           `tfsdk:"protocol"`
+	ProtocolMatchExcepted types.Bool             `tfsdk:"protocol_match_excepted"`
+	ProtocolV6            types.String           `tfsdk:"protocol_v6"`
+	RuleIndex             types.Int64            `tfsdk:"rule_index"`
+	Ruleset               types.String           `tfsdk:"ruleset"`
+	SettingPreference     types.String           `tfsdk:"setting_preference"`
+	Site                  types.String           `tfsdk:"site"`
+	SiteId                types.String           `tfsdk:"site_id"`
+	SrcAddress            types.String           `tfsdk:"src_address"`
+	SrcAddressIpv6        types.String           `tfsdk:"src_address_ipv6"`
+	SrcFirewallGroupIds   types.Set              `tfsdk:"src_firewall_group_ids"`
+	SrcMac                customtypes.MacAddress `tfsdk:"src_mac"`
+	SrcNetworkId          types.String           `tfsdk:"src_network_id"`
+	SrcNetworkType        types.String           `tfsdk:"src_network_type"`
+	SrcPort               types.String           `tfsdk:"src_port"`
+	StateEstablished      types.Bool             `tfsdk:"state_established"`
+	StateInvalid          types.Bool             `tfsdk:"state_invalid"`
+	StateNew              types.Bool             `tfsdk:"state_new"`
+	StateRelated          types.Bool             `tfsdk:"state_related"`
 }
@@ -17,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/validators"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -31,6 +33,13 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Whether or not to enable auto scaling on the Network.",
 				MarkdownDescription: "Whether or not to enable auto scaling on the Network.",
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether Terraform is prevented from deleting the Network. Set it to `false` and apply the change before destroying the Network. Defaults to `false`.",
+				MarkdownDescription: "Whether Terraform is prevented from deleting the Network. Set it to `false` and apply the change before destroying the Network. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
 			"dhcp_boot_enabled": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -48,6 +57,9 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "IPv4 address of a TFTP server to network boot from.",
 				MarkdownDescription: "IPv4 address of a TFTP server to network boot from.",
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
 			},
 			"dhcp_conflict_checking": schema.BoolAttribute{
 				Optional:            true,
@@ -63,6 +75,7 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "IPv4 addresses for the DNS server to be returned from the DHCP server.",
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(4),
+					listvalidator.ValueStringsAre(validators.IPv4Address()),
 				},
 			},
 			"dhcp_dns_enabled": schema.BoolAttribute{
@@ -112,12 +125,18 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The IPv4 address where the DHCP range of addresses start.",
 				MarkdownDescription: "The IPv4 address where the DHCP range of addresses start.",
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
 			},
 			"dhcp_stop": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "The IPv4 address where the DHCP range of addresses stop.",
 				MarkdownDescription: "The IPv4 address where the DHCP range of addresses stop.",
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
 			},
 			"dhcp_tftp_server": schema.StringAttribute{
 				Optional:            true,
@@ -136,6 +155,9 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "Specifies the Unifi Controller for DHCPd.",
 				MarkdownDescription: "Specifies the Unifi Controller for DHCPd.",
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
 			},
 			"dhcp_v6_allow_slaac": schema.BoolAttribute{
 				Optional:            true,
@@ -151,6 +173,7 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Specifies the IPv6 addresses for the DNS server to be returned from the DHCP server. Used if `dhcp_v6_dns_auto` is set to `false`.",
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(4),
+					listvalidator.ValueStringsAre(validators.IPv6Address()),
 				},
 			},
 			"dhcp_v6_dns_auto": schema.BoolAttribute{
@@ -176,12 +199,18 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "Start address of the DHCPv6 range. Used in static DHCPv6 configuration.",
 				MarkdownDescription: "Start address of the DHCPv6 range. Used in static DHCPv6 configuration.",
+				Validators: []validator.String{
+					validators.IPv6Address(),
+				},
 			},
 			"dhcp_v6_stop": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "End address of the DHCPv6 range. Used in static DHCPv6 configuration.",
 				MarkdownDescription: "End address of the DHCPv6 range. Used in static DHCPv6 configuration.",
+				Validators: []validator.String{
+					validators.IPv6Address(),
+				},
 			},
 			"dhcp_wins_enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -211,8 +240,11 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 			"gateway_type": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "Specifies the Gateway type.",
-				MarkdownDescription: "Specifies the Gateway type.",
+				Description:         "Specifies the Gateway type. Must be one of either `default` or `switch`.",
+				MarkdownDescription: "Specifies the Gateway type. Must be one of either `default` or `switch`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("default", "switch"),
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -237,8 +269,11 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 			"ipv6_client_address_assignment": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "Specifies the client address assignment for IPv6",
-				MarkdownDescription: "Specifies the client address assignment for IPv6",
+				Description:         "Specifies the client address assignment for IPv6. Must be one of either `slaac` or `dhcpv6`.",
+				MarkdownDescription: "Specifies the client address assignment for IPv6. Must be one of either `slaac` or `dhcpv6`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("slaac", "dhcpv6"),
+				},
 			},
 			"ipv6_enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -281,12 +316,18 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "Start address of the DHCPv6 range. Used if `ipv6_interface_type` is set to `pd`.",
 				MarkdownDescription: "Start address of the DHCPv6 range. Used if `ipv6_interface_type` is set to `pd`.",
+				Validators: []validator.String{
+					validators.IPv6Address(),
+				},
 			},
 			"ipv6_pd_stop": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "End address of the DHCPv6 range. Used if `ipv6_interface_type` is set to `pd`.",
 				MarkdownDescription: "End address of the DHCPv6 range. Used if `ipv6_interface_type` is set to `pd`.",
+				Validators: []validator.String{
+					validators.IPv6Address(),
+				},
 			},
 			"ipv6_ra_enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -318,14 +359,20 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 			"ipv6_setting_preference": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "Specifies the setting preference for IPv6.",
-				MarkdownDescription: "Specifies the setting preference for IPv6.",
+				Description:         "Specifies the setting preference for IPv6. Must be one of either `auto` or `manual`.",
+				MarkdownDescription: "Specifies the setting preference for IPv6. Must be one of either `auto` or `manual`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "manual"),
+				},
 			},
 			"ipv6_static_subnet": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "Specifies the static IPv6 subnet (when `ipv6_interface_type` is `static`).",
 				MarkdownDescription: "Specifies the static IPv6 subnet (when `ipv6_interface_type` is `static`).",
+				Validators: []validator.String{
+					validators.IPv6CIDR(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
 				Computed:            true,
@@ -358,6 +405,9 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 							Computed:            true,
 							Description:         "Specifies a single IP address to use for outbound NAT. Used when `mode` is set to `ip_address`.",
 							MarkdownDescription: "Specifies a single IP address to use for outbound NAT. Used when `mode` is set to `ip_address`.",
+							Validators: []validator.String{
+								validators.IPv4Address(),
+							},
 						},
 						"ip_address_pool": schema.ListAttribute{
 							ElementType:         types.StringType,
@@ -365,6 +415,9 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 							Computed:            true,
 							Description:         "Specifies a list of IP addresses to use for outbound NAT. Used when `mode` is set to `ip_address_pool`.",
 							MarkdownDescription: "Specifies a list of IP addresses to use for outbound NAT. Used when `mode` is set to `ip_address_pool`.",
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(validators.IPv4Address()),
+							},
 						},
 						"mode": schema.StringAttribute{
 							Optional:            true,
@@ -396,11 +449,24 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Specifies the outbound IP address pool for NAT.",
 				MarkdownDescription: "Specifies the outbound IP address pool for NAT.",
 			},
+			"netmask": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The IPv4 netmask of `subnet`, e.g. `255.255.255.0` for `192.168.1.1/24`.",
+				MarkdownDescription: "The IPv4 netmask of `subnet`, e.g. `255.255.255.0` for `192.168.1.1/24`.",
+			},
+			"network_address": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The network address of `subnet`, e.g. `192.168.1.0` for `192.168.1.1/24`.",
+				MarkdownDescription: "The network address of `subnet`, e.g. `192.168.1.0` for `192.168.1.1/24`.",
+			},
 			"network_group": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "The group of the Network.",
-				MarkdownDescription: "The group of the Network.",
+				Description:         "The group of the Network. Must be one of `LAN`, `WAN`, `WAN2` or `WAN_LTE_FAILOVER`.",
+				MarkdownDescription: "The group of the Network. Must be one of `LAN`, `WAN`, `WAN2` or `WAN_LTE_FAILOVER`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("LAN", "WAN", "WAN2", "WAN_LTE_FAILOVER"),
+				},
 			},
 			"network_isolation_enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -420,8 +486,11 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 			"setting_preference": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "Specifies the setting preference for the Network.",
-				MarkdownDescription: "Specifies the setting preference for the Network.",
+				Description:         "Specifies the setting preference for the Network. Must be one of either `auto` or `manual`.",
+				MarkdownDescription: "Specifies the setting preference for the Network. Must be one of either `auto` or `manual`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "manual"),
+				},
 			},
 			"site": schema.StringAttribute{
 				Required:            true,
@@ -437,10 +506,14 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 			"subnet": schema.StringAttribute{
+				CustomType:          customtypes.IPPrefixType{},
 				Optional:            true,
 				Computed:            true,
 				Description:         "The subnet of the Network (CIDR address).",
 				MarkdownDescription: "The subnet of the Network (CIDR address).",
+				Validators: []validator.String{
+					validators.IPv4CIDR(),
+				},
 			},
 			"upnp_lan_enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -457,8 +530,11 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 			"vlan_id": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "The VLAN ID of the Network.",
-				MarkdownDescription: "The VLAN ID of the Network.",
+				Description:         "The VLAN ID of the Network. Must be a number between 1 and 4094.",
+				MarkdownDescription: "The VLAN ID of the Network. Must be a number between 1 and 4094.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
 			},
 			"wan_dhcp_v6_pd_size": schema.Int64Attribute{
 				Optional:            true,
@@ -477,6 +553,7 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "DNS servers IPs of the WAN.",
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(4),
+					listvalidator.ValueStringsAre(validators.IPv4Address()),
 				},
 			},
 			"wan_egress_qos": schema.Int64Attribute{
@@ -490,30 +567,45 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The IPv4 gateway of the WAN.",
 				MarkdownDescription: "The IPv4 gateway of the WAN.",
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
 			},
 			"wan_gateway_v6": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "The IPv6 gateway of the WAN.",
 				MarkdownDescription: "The IPv6 gateway of the WAN.",
+				Validators: []validator.String{
+					validators.IPv6Address(),
+				},
 			},
 			"wan_ip": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "The IPv4 address of the WAN.",
 				MarkdownDescription: "The IPv4 address of the WAN.",
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
 			},
 			"wan_ipv6": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "The IPv6 address of the WAN.",
 				MarkdownDescription: "The IPv6 address of the WAN.",
+				Validators: []validator.String{
+					validators.IPv6Address(),
+				},
 			},
 			"wan_netmask": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "The IPv4 netmask of the WAN.",
 				MarkdownDescription: "The IPv4 netmask of the WAN.",
+				Validators: []validator.String{
+					validators.IPv4Netmask(),
+				},
 			},
 			"wan_network_group": schema.StringAttribute{
 				Optional:            true,
@@ -568,82 +660,85 @@ func NetworkResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type NetworkModel struct {
-	AutoScaleEnabled            types.Bool   `tfsdk:"auto_scale_enabled"`
-	DhcpBootEnabled             types.Bool   `tfsdk:"dhcp_boot_enabled"`
-	DhcpBootFilename            types.String `tfsdk:"dhcp_boot_filename"`
-	DhcpBootServer              types.String `tfsdk:"dhcp_boot_server"`
-	DhcpConflictChecking        types.Bool   `tfsdk:"dhcp_conflict_checking"`
-	DhcpDns                     types.List   `tfsdk:"dhcp_dns"`
-	DhcpDnsEnabled              types.Bool   `tfsdk:"dhcp_dns_enabled"`
-	DhcpEnabled                 types.Bool   `tfsdk:"dhcp_enabled"`
-	DhcpGatewayEnabled          types.Bool   `tfsdk:"dhcp_gateway_enabled"`
-	DhcpGuardEnabled            types.Bool   `tfsdk:"dhcp_guard_enabled"`
-	DhcpLeaseTime               types.Int64  `tfsdk:"dhcp_lease_time"`
-	DhcpNtpEnabled              types.Bool   `tfsdk:"dhcp_ntp_enabled"`
-	DhcpRelayEnabled            types.Bool   `tfsdk:"dhcp_relay_enabled"`
-	DhcpStart                   types.String `tfsdk:"dhcp_start"`
-	DhcpStop                    types.String `tfsdk:"dhcp_stop"`
-	DhcpTftpServer              types.String `tfsdk:"dhcp_tftp_server"`
-	DhcpTimeOffsetEnabled       types.Bool   `tfsdk:"dhcp_time_offset_enabled"`
-	DhcpUnifiController         types.String `tfsdk:"dhcp_unifi_controller"`
-	DhcpV6AllowSlaac            types.Bool   `tfsdk:"dhcp_v6_allow_slaac"`
-	DhcpV6Dns                   types.List   `tfsdk:"dhcp_v6_dns"`
-	DhcpV6DnsAuto               types.Bool   `tfsdk:"dhcp_v6_dns_auto"`
-	DhcpV6Enabled               types.Bool   `tfsdk:"dhcp_v6_enabled"`
-	DhcpV6LeaseTime             types.Int64  `tfsdk:"dhcp_v6_lease_time"`
-	DhcpV6Start                 types.String `tfsdk:"dhcp_v6_start"`
-	DhcpV6Stop                  types.String `tfsdk:"dhcp_v6_stop"`
-	DhcpWinsEnabled             types.Bool   `tfsdk:"dhcp_wins_enabled"`
-	DhcpWpadUrl                 types.String `tfsdk:"dhcp_wpad_url"`
-	DomainName                  types.String `tfsdk:"domain_name"`
-	Enabled                     types.Bool   `tfsdk:"enabled"`
-	GatewayType                 types.String `tfsdk:"gateway_type"`
-	Id                          types.String `tfsdk:"id"`
-	IgmpSnooping                types.Bool   `tfsdk:"igmp_snooping"`
-	InternetAccessEnabled       types.Bool   `tfsdk:"internet_access_enabled"`
-	Ipv6ClientAddressAssignment types.String `tfsdk:"ipv6_client_address_assignment"`
-	Ipv6Enabled                 types.Bool   `tfsdk:"ipv6_enabled"`
-	Ipv6InterfaceType           types.String `tfsdk:"ipv6_interface_type"`
-	Ipv6PdAutoPrefixidEnabled   types.Bool   `tfsdk:"ipv6_pd_auto_prefixid_enabled"`
-	Ipv6PdInterface             types.String `tfsdk:"ipv6_pd_interface"`
-	Ipv6PdPrefixid              types.String `tfsdk:"ipv6_pd_prefixid"`
-	Ipv6PdStart                 types.String `tfsdk:"ipv6_pd_start"`
-	Ipv6PdStop                  types.String `tfsdk:"ipv6_pd_stop"`
-	Ipv6RaEnabled               types.Bool   `tfsdk:"ipv6_ra_enabled"`
-	Ipv6RaPreferredLifetime     types.Int64  `tfsdk:"ipv6_ra_preferred_lifetime"`
-	Ipv6RaPriority              types.String `tfsdk:"ipv6_ra_priority"`
-	Ipv6RaValidLifetime         types.Int64  `tfsdk:"ipv6_ra_valid_lifetime"`
-	Ipv6SettingPreference       types.String `tfsdk:"ipv6_setting_preference"`
-	Ipv6StaticSubnet            types.String `tfsdk:"ipv6_static_subnet"`
-	LastUpdated                 types.String `tfsdk:"last_updated"`
-	LteLanEnabled               types.Bool   `tfsdk:"lte_lan_enabled"`
-	MulticastDnsEnabled         types.Bool   `tfsdk:"multicast_dns_enabled"`
-	Name                        types.String `tfsdk:"name"`
-	NatOutboundIpAddresses      types.List   `tfsdk:"nat_outbound_ip_addresses"`
-	NetworkGroup                types.String `tfsdk:"network_group"`
-	NetworkIsolationEnabled     types.Bool   `tfsdk:"network_isolation_enabled"`
-	Purpose                     types.String `tfsdk:"purpose"`
-	SettingPreference           types.String `tfsdk:"setting_preference"`
-	Site                        types.String `tfsdk:"site"`
-	SiteId                      types.String `tfsdk:"site_id"`
-	Subnet                      types.String `tfsdk:"subnet"`
-	UpnpLanEnabled              types.Bool   `tfsdk:"upnp_lan_enabled"`
-	VlanEnabled                 types.Bool   `tfsdk:"vlan_enabled"`
-	VlanId                      types.Int64  `tfsdk:"vlan_id"`
-	WanDhcpV6PdSize             types.Int64  `tfsdk:"wan_dhcp_v6_pd_size"`
-	WanDns                      types.List   `tfsdk:"wan_dns"`
-	WanEgressQos                types.Int64  `tfsdk:"wan_egress_qos"`
-	WanGateway                  types.String `tfsdk:"wan_gateway"`
-	WanGatewayV6                types.String `tfsdk:"wan_gateway_v6"`
-	WanIp                       types.String `tfsdk:"wan_ip"`
-	WanIpv6                     types.String `tfsdk:"wan_ipv6"`
-	WanNetmask                  types.String `tfsdk:"wan_netmask"`
-	WanNetworkGroup             types.String `tfsdk:"wan_network_group"`
-	WanPassword                 types.String `tfsdk:"wan_password"`
-	WanPrefixlen                types.Int64  `tfsdk:"wan_prefixlen"`
-	WanType                     types.String `tfsdk:"wan_type"`
-	WanTypeV6                   types.String `tfsdk:"wan_type_v6"`
-	WanUsername                 types.String `tfsdk:"wan_username"`
+	AutoScaleEnabled            types.Bool           `tfsdk:"auto_scale_enabled"`
+	DeletionProtection          types.Bool           `tfsdk:"deletion_protection"`
+	DhcpBootEnabled             types.Bool           `tfsdk:"dhcp_boot_enabled"`
+	DhcpBootFilename            types.String         `tfsdk:"dhcp_boot_filename"`
+	DhcpBootServer              types.String         `tfsdk:"dhcp_boot_server"`
+	DhcpConflictChecking        types.Bool           `tfsdk:"dhcp_conflict_checking"`
+	DhcpDns                     types.List           `tfsdk:"dhcp_dns"`
+	DhcpDnsEnabled              types.Bool           `tfsdk:"dhcp_dns_enabled"`
+	DhcpEnabled                 types.Bool           `tfsdk:"dhcp_enabled"`
+	DhcpGatewayEnabled          types.Bool           `tfsdk:"dhcp_gateway_enabled"`
+	DhcpGuardEnabled            types.Bool           `tfsdk:"dhcp_guard_enabled"`
+	DhcpLeaseTime               types.Int64          `tfsdk:"dhcp_lease_time"`
+	DhcpNtpEnabled              types.Bool           `tfsdk:"dhcp_ntp_enabled"`
+	DhcpRelayEnabled            types.Bool           `tfsdk:"dhcp_relay_enabled"`
+	DhcpStart                   types.String         `tfsdk:"dhcp_start"`
+	DhcpStop                    types.String         `tfsdk:"dhcp_stop"`
+	DhcpTftpServer              types.String         `tfsdk:"dhcp_tftp_server"`
+	DhcpTimeOffsetEnabled       types.Bool           `tfsdk:"dhcp_time_offset_enabled"`
+	DhcpUnifiController         types.String         `tfsdk:"dhcp_unifi_controller"`
+	DhcpV6AllowSlaac            types.Bool           `tfsdk:"dhcp_v6_allow_slaac"`
+	DhcpV6Dns                   types.List           `tfsdk:"dhcp_v6_dns"`
+	DhcpV6DnsAuto               types.Bool           `tfsdk:"dhcp_v6_dns_auto"`
+	DhcpV6Enabled               types.Bool           `tfsdk:"dhcp_v6_enabled"`
+	DhcpV6LeaseTime             types.Int64          `tfsdk:"dhcp_v6_lease_time"`
+	DhcpV6Start                 types.String         `tfsdk:"dhcp_v6_start"`
+	DhcpV6Stop                  types.String         `tfsdk:"dhcp_v6_stop"`
+	DhcpWinsEnabled             types.Bool           `tfsdk:"dhcp_wins_enabled"`
+	DhcpWpadUrl                 types.String         `tfsdk:"dhcp_wpad_url"`
+	DomainName                  types.String         `tfsdk:"domain_name"`
+	Enabled                     types.Bool           `tfsdk:"enabled"`
+	GatewayType                 types.String         `tfsdk:"gateway_type"`
+	Id                          types.String         `tfsdk:"id"`
+	IgmpSnooping                types.Bool           `tfsdk:"igmp_snooping"`
+	InternetAccessEnabled       types.Bool           `tfsdk:"internet_access_enabled"`
+	Ipv6ClientAddressAssignment types.String         `tfsdk:"ipv6_client_address_assignment"`
+	Ipv6Enabled                 types.Bool           `tfsdk:"ipv6_enabled"`
+	Ipv6InterfaceType           types.String         `tfsdk:"ipv6_interface_type"`
+	Ipv6PdAutoPrefixidEnabled   types.Bool           `tfsdk:"ipv6_pd_auto_prefixid_enabled"`
+	Ipv6PdInterface             types.String         `tfsdk:"ipv6_pd_interface"`
+	Ipv6PdPrefixid              types.String         `tfsdk:"ipv6_pd_prefixid"`
+	Ipv6PdStart                 types.String         `tfsdk:"ipv6_pd_start"`
+	Ipv6PdStop                  types.String         `tfsdk:"ipv6_pd_stop"`
+	Ipv6RaEnabled               types.Bool           `tfsdk:"ipv6_ra_enabled"`
+	Ipv6RaPreferredLifetime     types.Int64          `tfsdk:"ipv6_ra_preferred_lifetime"`
+	Ipv6RaPriority              types.String         `tfsdk:"ipv6_ra_priority"`
+	Ipv6RaValidLifetime         types.Int64          `tfsdk:"ipv6_ra_valid_lifetime"`
+	Ipv6SettingPreference       types.String         `tfsdk:"ipv6_setting_preference"`
+	Ipv6StaticSubnet            types.String         `tfsdk:"ipv6_static_subnet"`
+	LastUpdated                 types.String         `tfsdk:"last_updated"`
+	LteLanEnabled               types.Bool           `tfsdk:"lte_lan_enabled"`
+	MulticastDnsEnabled         types.Bool           `tfsdk:"multicast_dns_enabled"`
+	Name                        types.String         `tfsdk:"name"`
+	NatOutboundIpAddresses      types.List           `tfsdk:"nat_outbound_ip_addresses"`
+	Netmask                     types.String         `tfsdk:"netmask"`
+	NetworkAddress              types.String         `tfsdk:"network_address"`
+	NetworkGroup                types.String         `tfsdk:"network_group"`
+	NetworkIsolationEnabled     types.Bool           `tfsdk:"network_isolation_enabled"`
+	Purpose                     types.String         `tfsdk:"purpose"`
+	SettingPreference           types.String         `tfsdk:"setting_preference"`
+	Site                        types.String         `tfsdk:"site"`
+	SiteId                      types.String         `tfsdk:"site_id"`
+	Subnet                      customtypes.IPPrefix `tfsdk:"subnet"`
+	UpnpLanEnabled              types.Bool           `tfsdk:"upnp_lan_enabled"`
+	VlanEnabled                 types.Bool           `tfsdk:"vlan_enabled"`
+	VlanId                      types.Int64          `tfsdk:"vlan_id"`
+	WanDhcpV6PdSize             types.Int64          `tfsdk:"wan_dhcp_v6_pd_size"`
+	WanDns                      types.List           `tfsdk:"wan_dns"`
+	WanEgressQos                types.Int64          `tfsdk:"wan_egress_qos"`
+	WanGateway                  types.String         `tfsdk:"wan_gateway"`
+	WanGatewayV6                types.String         `tfsdk:"wan_gateway_v6"`
+	WanIp                       types.String         `tfsdk:"wan_ip"`
+	WanIpv6                     types.String         `tfsdk:"wan_ipv6"`
+	WanNetmask                  types.String         `tfsdk:"wan_netmask"`
+	WanNetworkGroup             types.String         `tfsdk:"wan_network_group"`
+	WanPassword                 types.String         `tfsdk:"wan_password"`
+	WanPrefixlen                types.Int64          `tfsdk:"wan_prefixlen"`
+	WanType                     types.String         `tfsdk:"wan_type"`
+	WanTypeV6                   types.String         `tfsdk:"wan_type_v6"`
+	WanUsername                 types.String         `tfsdk:"wan_username"`
 }
 
 var _ basetypes.ObjectTypable = NatOutboundIpAddressesType{}
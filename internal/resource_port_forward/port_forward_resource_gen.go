@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
@@ -21,6 +23,9 @@ func PortForwardResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The destination port for the Port Forward.",
 				MarkdownDescription: "The destination port for the Port Forward.",
+				Validators: []validator.String{
+					validators.PortSpec(),
+				},
 			},
 			"enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -29,16 +34,23 @@ func PortForwardResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Specifies whether the Port Forward rule is enabled or not.",
 			},
 			"fwd_ip": schema.StringAttribute{
+				CustomType:          customtypes.IPAddressType{},
 				Optional:            true,
 				Computed:            true,
 				Description:         "The IPv4 address to forward the traffic to.",
 				MarkdownDescription: "The IPv4 address to forward the traffic to.",
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
 			},
 			"fwd_port": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "The port to forward traffic to.",
 				MarkdownDescription: "The port to forward traffic to.",
+				Validators: []validator.String{
+					validators.PortSpec(),
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -100,23 +112,26 @@ func PortForwardResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The source IPv4 address (or CIDR) of the Port Forward rule. For all traffic specify `any`.",
 				MarkdownDescription: "The source IPv4 address (or CIDR) of the Port Forward rule. For all traffic specify `any`.",
+				Validators: []validator.String{
+					stringvalidator.Any(stringvalidator.OneOf("any"), validators.IPv4AddressOrCIDR()),
+				},
 			},
 		},
 	}
 }
 
 type PortForwardModel struct {
-	DstPort              types.String `tfsdk:"dst_port"`
-	Enabled              types.Bool   `tfsdk:"enabled"`
-	FwdIp                types.String `tfsdk:"fwd_ip"`
-	FwdPort              types.String `tfsdk:"fwd_port"`
-	Id                   types.String `tfsdk:"id"`
-	LastUpdated          types.String `tfsdk:"last_updated"`
-	Log                  types.Bool   `tfsdk:"log"`
-	Name                 types.String `tfsdk:"name"`
-	PortForwardInterface types.String `tfsdk:"port_forward_interface"`
-	Protocol             types.String `tfsdk:"protocol"`
-	Site                 types.String `tfsdk:"site"`
-	SiteId               types.String `tfsdk:"site_id"`
-	SrcIp                types.String `tfsdk:"src_ip"`
+	DstPort              types.String          `tfsdk:"dst_port"`
+	Enabled              types.Bool            `tfsdk:"enabled"`
+	FwdIp                customtypes.IPAddress `tfsdk:"fwd_ip"`
+	FwdPort              types.String          `tfsdk:"fwd_port"`
+	Id                   types.String          `tfsdk:"id"`
+	LastUpdated          types.String          `tfsdk:"last_updated"`
+	Log                  types.Bool            `tfsdk:"log"`
+	Name                 types.String          `tfsdk:"name"`
+	PortForwardInterface types.String          `tfsdk:"port_forward_interface"`
+	Protocol             types.String          `tfsdk:"protocol"`
+	Site                 types.String          `tfsdk:"site"`
+	SiteId               types.String          `tfsdk:"site_id"`
+	SrcIp                types.String          `tfsdk:"src_ip"`
 }
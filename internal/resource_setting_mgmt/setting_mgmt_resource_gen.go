@@ -5,10 +5,12 @@ package resource_setting_mgmt
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -58,7 +60,7 @@ func SettingMgmtResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Enable SSH authentication.",
 				MarkdownDescription: "Enable SSH authentication.",
 			},
-			"ssh_keys": schema.ListNestedAttribute{
+			"ssh_keys": schema.SetNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"comment": schema.StringAttribute{
@@ -82,8 +84,11 @@ func SettingMgmtResourceSchema(ctx context.Context) schema.Schema {
 						"type": schema.StringAttribute{
 							Optional:            true,
 							Computed:            true,
-							Description:         "Type of the SSH key, e.g. ssh-rsa.",
-							MarkdownDescription: "Type of the SSH key, e.g. ssh-rsa.",
+							Description:         "Type of the SSH key. Must be one of `ssh-rsa`, `ssh-dss`, `ssh-ed25519`, `ecdsa-sha2-nistp256`, `ecdsa-sha2-nistp384` or `ecdsa-sha2-nistp521`.",
+							MarkdownDescription: "Type of the SSH key. Must be one of `ssh-rsa`, `ssh-dss`, `ssh-ed25519`, `ecdsa-sha2-nistp256`, `ecdsa-sha2-nistp384` or `ecdsa-sha2-nistp521`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("ssh-rsa", "ssh-dss", "ssh-ed25519", "ecdsa-sha2-nistp256", "ecdsa-sha2-nistp384", "ecdsa-sha2-nistp521"),
+							},
 						},
 					},
 					CustomType: SshKeysType{
@@ -108,7 +113,7 @@ type SettingMgmtModel struct {
 	Site        types.String `tfsdk:"site"`
 	SiteId      types.String `tfsdk:"site_id"`
 	SshEnabled  types.Bool   `tfsdk:"ssh_enabled"`
-	SshKeys     types.List   `tfsdk:"ssh_keys"`
+	SshKeys     types.Set    `tfsdk:"ssh_keys"`
 }
 
 var _ basetypes.ObjectTypable = SshKeysType{}
@@ -4,8 +4,10 @@ package resource_setting_radius
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,12 +27,18 @@ func SettingRadiusResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The port for accounting communications.",
 				MarkdownDescription: "The port for accounting communications.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"auth_port": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
 				Description:         "The port for authentication communications.",
 				MarkdownDescription: "The port for authentication communications.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"enabled": schema.BoolAttribute{
 				Optional:            true,
@@ -4,6 +4,7 @@ package resource_site
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -14,6 +15,13 @@ import (
 func SiteResourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether Terraform is prevented from deleting the Site. Set it to `false` and apply the change before destroying the Site. Defaults to `false`.",
+				MarkdownDescription: "Whether Terraform is prevented from deleting the Site. Set it to `false` and apply the change before destroying the Site. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
 			"description": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -43,8 +51,9 @@ func SiteResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type SiteModel struct {
-	Description types.String `tfsdk:"description"`
-	Id          types.String `tfsdk:"id"`
-	LastUpdate  types.String `tfsdk:"last_update"`
-	Name        types.String `tfsdk:"name"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	Description        types.String `tfsdk:"description"`
+	Id                 types.String `tfsdk:"id"`
+	LastUpdate         types.String `tfsdk:"last_update"`
+	Name               types.String `tfsdk:"name"`
 }
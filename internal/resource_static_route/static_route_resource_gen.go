@@ -4,11 +4,14 @@ package resource_static_route
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
@@ -21,6 +24,9 @@ func StaticRouteResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The distance of the Static Route.",
 				MarkdownDescription: "The distance of the Static Route.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 255),
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -47,6 +53,7 @@ func StaticRouteResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The name of the Static Route.",
 			},
 			"network": schema.StringAttribute{
+				CustomType:          customtypes.IPNetworkType{},
 				Optional:            true,
 				Computed:            true,
 				Description:         "The network subnet address.",
@@ -57,6 +64,9 @@ func StaticRouteResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The next hop of the Static Route (only valid for `nexthop-route` type).",
 				MarkdownDescription: "The next hop of the Static Route (only valid for `nexthop-route` type).",
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
 			},
 			"site": schema.StringAttribute{
 				Required:            true,
@@ -85,14 +95,14 @@ func StaticRouteResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type StaticRouteModel struct {
-	Distance   types.Int64  `tfsdk:"distance"`
-	Id         types.String `tfsdk:"id"`
-	Interface  types.String `tfsdk:"interface"`
-	LastUpdate types.String `tfsdk:"last_update"`
-	Name       types.String `tfsdk:"name"`
-	Network    types.String `tfsdk:"network"`
-	NextHop    types.String `tfsdk:"next_hop"`
-	Site       types.String `tfsdk:"site"`
-	SiteId     types.String `tfsdk:"site_id"`
-	Type       types.String `tfsdk:"type"`
+	Distance   types.Int64           `tfsdk:"distance"`
+	Id         types.String          `tfsdk:"id"`
+	Interface  types.String          `tfsdk:"interface"`
+	LastUpdate types.String          `tfsdk:"last_update"`
+	Name       types.String          `tfsdk:"name"`
+	Network    customtypes.IPNetwork `tfsdk:"network"`
+	NextHop    types.String          `tfsdk:"next_hop"`
+	Site       types.String          `tfsdk:"site"`
+	SiteId     types.String          `tfsdk:"site_id"`
+	Type       types.String          `tfsdk:"type"`
 }
@@ -6,7 +6,10 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
@@ -27,10 +30,14 @@ func UserResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "Override the device fingerprint.",
 			},
 			"fixed_ip": schema.StringAttribute{
+				CustomType:          customtypes.IPAddressType{},
 				Optional:            true,
 				Computed:            true,
 				Description:         "Fixed IPv4 address set for the User.",
 				MarkdownDescription: "Fixed IPv4 address set for the User.",
+				Validators: []validator.String{
+					validators.IPv4Address(),
+				},
 			},
 			"hostname": schema.StringAttribute{
 				Optional:            true,
@@ -51,6 +58,9 @@ func UserResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				Description:         "The IP address of the User.",
 				MarkdownDescription: "The IP address of the User.",
+				Validators: []validator.String{
+					validators.IPAddress(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
 				Computed:            true,
@@ -64,6 +74,7 @@ func UserResourceSchema(ctx context.Context) schema.Schema {
 				MarkdownDescription: "The local DNS record for the User.",
 			},
 			"mac": schema.StringAttribute{
+				CustomType:          customtypes.MacAddressType{},
 				Optional:            true,
 				Computed:            true,
 				Description:         "The MAC address of the User.",
@@ -110,19 +121,19 @@ func UserResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type UserModel struct {
-	Blocked        types.Bool   `tfsdk:"blocked"`
-	DevIdOverride  types.Int64  `tfsdk:"dev_id_override"`
-	FixedIp        types.String `tfsdk:"fixed_ip"`
-	Hostname       types.String `tfsdk:"hostname"`
-	Id             types.String `tfsdk:"id"`
-	Ip             types.String `tfsdk:"ip"`
-	LastUpdated    types.String `tfsdk:"last_updated"`
-	LocalDnsRecord types.String `tfsdk:"local_dns_record"`
-	Mac            types.String `tfsdk:"mac"`
-	Name           types.String `tfsdk:"name"`
-	NetworkId      types.String `tfsdk:"network_id"`
-	Note           types.String `tfsdk:"note"`
-	Site           types.String `tfsdk:"site"`
-	SiteId         types.String `tfsdk:"site_id"`
-	UserGroupId    types.String `tfsdk:"user_group_id"`
+	Blocked        types.Bool             `tfsdk:"blocked"`
+	DevIdOverride  types.Int64            `tfsdk:"dev_id_override"`
+	FixedIp        customtypes.IPAddress  `tfsdk:"fixed_ip"`
+	Hostname       types.String           `tfsdk:"hostname"`
+	Id             types.String           `tfsdk:"id"`
+	Ip             types.String           `tfsdk:"ip"`
+	LastUpdated    types.String           `tfsdk:"last_updated"`
+	LocalDnsRecord types.String           `tfsdk:"local_dns_record"`
+	Mac            customtypes.MacAddress `tfsdk:"mac"`
+	Name           types.String           `tfsdk:"name"`
+	NetworkId      types.String           `tfsdk:"network_id"`
+	Note           types.String           `tfsdk:"note"`
+	Site           types.String           `tfsdk:"site"`
+	SiteId         types.String           `tfsdk:"site_id"`
+	UserGroupId    types.String           `tfsdk:"user_group_id"`
 }
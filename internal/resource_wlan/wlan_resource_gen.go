@@ -7,15 +7,19 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+	"github.com/zoullx/terraform-provider-unifi/internal/validators"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -46,7 +50,7 @@ func WlanResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "TODO: Figure out what this is.",
 				MarkdownDescription: "TODO: Figure out what this is.",
 			},
-			"broadcast_filter_list": schema.ListAttribute{
+			"broadcast_filter_list": schema.SetAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
 				Computed:            true,
@@ -59,6 +63,13 @@ func WlanResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Improves client transitions between APs when they have a weak signal.",
 				MarkdownDescription: "Improves client transitions between APs when they have a weak signal.",
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether Terraform is prevented from deleting the WLAN. Set it to `false` and apply the change before destroying the WLAN. Defaults to `false`.",
+				MarkdownDescription: "Whether Terraform is prevented from deleting the WLAN. Set it to `false` and apply the change before destroying the WLAN. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
 			"dtim_2g": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
@@ -165,12 +176,15 @@ func WlanResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "Indicates whether or not the MAC filter is turned on for the network.",
 				MarkdownDescription: "Indicates whether or not the MAC filter is turned on for the network.",
 			},
-			"mac_filter_list": schema.ListAttribute{
-				ElementType:         types.StringType,
+			"mac_filter_list": schema.SetAttribute{
+				ElementType:         customtypes.MacAddressType{},
 				Optional:            true,
 				Computed:            true,
 				Description:         "List of MAC addresses to filter (only valid if `mac_filter_enabled` is `true`).",
 				MarkdownDescription: "List of MAC addresses to filter (only valid if `mac_filter_enabled` is `true`).",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.MACAddress()),
+				},
 			},
 			"mac_filter_policy": schema.StringAttribute{
 				Optional:            true,
@@ -375,6 +389,9 @@ func WlanResourceSchema(ctx context.Context) schema.Schema {
 							Computed:            true,
 							Description:         "MAC Address of the SAE Psk.",
 							MarkdownDescription: "MAC Address of the SAE Psk.",
+							Validators: []validator.String{
+								validators.MACAddress(),
+							},
 						},
 						"psk": schema.StringAttribute{
 							Optional:            true,
@@ -387,6 +404,9 @@ func WlanResourceSchema(ctx context.Context) schema.Schema {
 							Computed:            true,
 							Description:         "VLAN for this SAE Psk.",
 							MarkdownDescription: "VLAN for this SAE Psk.",
+							Validators: []validator.Int64{
+								int64validator.Between(1, 4094),
+							},
 						},
 					},
 					CustomType: SaePsksType{
@@ -567,8 +587,9 @@ type WlanModel struct {
 	ApGroupIds                       types.List   `tfsdk:"ap_group_ids"`
 	ApGroupMode                      types.String `tfsdk:"ap_group_mode"`
 	BSupported                       types.Bool   `tfsdk:"b_supported"`
-	BroadcastFilterList              types.List   `tfsdk:"broadcast_filter_list"`
+	BroadcastFilterList              types.Set    `tfsdk:"broadcast_filter_list"`
 	BssTransition                    types.Bool   `tfsdk:"bss_transition"`
+	DeletionProtection               types.Bool   `tfsdk:"deletion_protection"`
 	Dtim2g                           types.Int64  `tfsdk:"dtim_2g"`
 	Dtim5g                           types.Int64  `tfsdk:"dtim_5g"`
 	Dtim6e                           types.Int64  `tfsdk:"dtim_6e"`
@@ -586,7 +607,7 @@ type WlanModel struct {
 	L2Isolation                      types.Bool   `tfsdk:"l2_isolation"`
 	LastUpdated                      types.String `tfsdk:"last_updated"`
 	MacFilterEnabled                 types.Bool   `tfsdk:"mac_filter_enabled"`
-	MacFilterList                    types.List   `tfsdk:"mac_filter_list"`
+	MacFilterList                    types.Set    `tfsdk:"mac_filter_list"`
 	MacFilterPolicy                  types.String `tfsdk:"mac_filter_policy"`
 	Minimum2gAdvertisingRates        types.Bool   `tfsdk:"minimum_2g_advertising_rates"`
 	Minimum2gDataRateEnabled         types.Bool   `tfsdk:"minimum_2g_data_rate_enabled"`
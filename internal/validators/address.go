@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"net"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/zoullx/terraform-provider-unifi/internal/customtypes"
+)
+
+// IPAddress validates that a string is an IPv4 or IPv6 address.
+func IPAddress() validator.String {
+	return stringValidator{
+		description: "an IPv4 or IPv6 address",
+		valid: func(value string) bool {
+			_, err := netip.ParseAddr(value)
+			return err == nil
+		},
+	}
+}
+
+// IPv4Address validates that a string is an IPv4 address, e.g. 192.168.1.1.
+func IPv4Address() validator.String {
+	return stringValidator{
+		description: "an IPv4 address, e.g. 192.168.1.1",
+		valid: func(value string) bool {
+			addr, err := netip.ParseAddr(value)
+			return err == nil && addr.Is4()
+		},
+	}
+}
+
+// IPv6Address validates that a string is an IPv6 address, e.g. fd00::1.
+func IPv6Address() validator.String {
+	return stringValidator{
+		description: "an IPv6 address, e.g. fd00::1",
+		valid: func(value string) bool {
+			addr, err := netip.ParseAddr(value)
+			return err == nil && addr.Is6() && !addr.Is4In6()
+		},
+	}
+}
+
+// CIDR validates that a string is an IPv4 or IPv6 address with a prefix
+// length. The address doesn't need to be the network address, so gateway
+// addresses such as 192.168.1.1/24 are valid.
+func CIDR() validator.String {
+	return stringValidator{
+		description: "an IPv4 or IPv6 CIDR",
+		valid: func(value string) bool {
+			_, err := netip.ParsePrefix(value)
+			return err == nil
+		},
+	}
+}
+
+// IPv4CIDR validates that a string is an IPv4 address with a prefix length,
+// e.g. 192.168.1.1/24.
+func IPv4CIDR() validator.String {
+	return stringValidator{
+		description: "an IPv4 CIDR, e.g. 192.168.1.1/24",
+		valid: func(value string) bool {
+			prefix, err := netip.ParsePrefix(value)
+			return err == nil && prefix.Addr().Is4()
+		},
+	}
+}
+
+// IPv6CIDR validates that a string is an IPv6 address with a prefix length,
+// e.g. fd00::1/64.
+func IPv6CIDR() validator.String {
+	return stringValidator{
+		description: "an IPv6 CIDR, e.g. fd00::1/64",
+		valid: func(value string) bool {
+			prefix, err := netip.ParsePrefix(value)
+			return err == nil && prefix.Addr().Is6() && !prefix.Addr().Is4In6()
+		},
+	}
+}
+
+// IPv4AddressOrCIDR validates that a string is an IPv4 address or CIDR.
+func IPv4AddressOrCIDR() validator.String {
+	return stringValidator{
+		description: "an IPv4 address or CIDR, e.g. 192.168.1.1 or 192.168.1.0/24",
+		valid: func(value string) bool {
+			if prefix, err := netip.ParsePrefix(value); err == nil {
+				return prefix.Addr().Is4()
+			}
+			addr, err := netip.ParseAddr(value)
+			return err == nil && addr.Is4()
+		},
+	}
+}
+
+// IPv6AddressOrCIDR validates that a string is an IPv6 address or CIDR.
+func IPv6AddressOrCIDR() validator.String {
+	return stringValidator{
+		description: "an IPv6 address or CIDR, e.g. fd00::1 or fd00::/64",
+		valid: func(value string) bool {
+			if prefix, err := netip.ParsePrefix(value); err == nil {
+				return prefix.Addr().Is6() && !prefix.Addr().Is4In6()
+			}
+			addr, err := netip.ParseAddr(value)
+			return err == nil && addr.Is6() && !addr.Is4In6()
+		},
+	}
+}
+
+// IPv4Netmask validates that a string is an IPv4 netmask in dotted decimal
+// notation, e.g. 255.255.255.0.
+func IPv4Netmask() validator.String {
+	return stringValidator{
+		description: "an IPv4 netmask, e.g. 255.255.255.0",
+		valid: func(value string) bool {
+			addr, err := netip.ParseAddr(value)
+			if err != nil || !addr.Is4() {
+				return false
+			}
+			b := addr.As4()
+			_, bits := net.IPv4Mask(b[0], b[1], b[2], b[3]).Size()
+			return bits == 32
+		},
+	}
+}
+
+// MACAddress validates that a string is a MAC address of six octets
+// separated by colons or hyphens, e.g. 00:11:22:aa:bb:cc.
+func MACAddress() validator.String {
+	return stringValidator{
+		description: "a MAC address, e.g. 00:11:22:aa:bb:cc",
+		valid: func(value string) bool {
+			_, err := customtypes.NormalizeMacAddress(value)
+			return err == nil
+		},
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	firewallProtocols = []string{
+		"all", "tcp_udp", "tcp", "udp", "icmp", "ah", "ax.25", "dccp", "ddp", "egp", "eigrp", "encap", "esp",
+		"etherip", "fc", "ggp", "gre", "hip", "hmp", "idpr-cmtp", "idrp", "igmp", "igp", "ip", "ipcomp", "ipencap",
+		"ipip", "ipv6", "ipv6-frag", "ipv6-icmp", "ipv6-nonxt", "ipv6-opts", "ipv6-route", "isis", "iso-tp4",
+		"l2tp", "manet", "mobility-header", "mpls-in-ip", "ospf", "pim", "pup", "rdp", "rohc", "rspf", "rsvp",
+		"sctp", "shim6", "skip", "st", "udplite", "vmtp", "vrrp", "wesp", "xns-idp", "xtp",
+	}
+
+	firewallProtocolsV6 = []string{
+		"all", "tcp_udp", "tcp", "udp", "icmpv6", "ah", "dccp", "eigrp", "esp", "gre", "ipcomp", "ipv6",
+		"ipv6-frag", "ipv6-icmp", "ipv6-nonxt", "ipv6-opts", "ipv6-route", "isis", "l2tp", "manet",
+		"mobility-header", "mpls-in-ip", "ospf", "pim", "rsvp", "sctp", "shim6", "vrrp",
+	}
+
+	icmpTypeNames = []string{
+		"any", "address-mask-reply", "address-mask-request", "communication-prohibited",
+		"destination-unreachable", "echo-reply", "echo-request", "fragmentation-needed",
+		"host-precedence-violation", "host-prohibited", "host-redirect", "host-unknown", "host-unreachable",
+		"ip-header-bad", "network-prohibited", "network-redirect", "network-unknown", "network-unreachable",
+		"parameter-problem", "port-unreachable", "precedence-cutoff", "protocol-unreachable", "redirect",
+		"required-option-missing", "router-advertisement", "router-solicitation", "source-quench",
+		"source-route-failed", "time-exceeded", "timestamp-reply", "timestamp-request", "TOS-host-redirect",
+		"TOS-host-unreachable", "TOS-network-redirect", "TOS-network-unreachable", "ttl-zero-during-reassembly",
+		"ttl-zero-during-transit",
+	}
+
+	icmpV6TypeNames = []string{
+		"address-unreachable", "bad-header", "beyond-scope", "communication-prohibited",
+		"destination-unreachable", "echo-reply", "echo-request", "failed-policy", "neighbor-advertisement",
+		"neighbor-solicitation", "no-route", "packet-too-big", "parameter-problem", "port-unreachable",
+		"redirect", "reject-route", "router-advertisement", "router-solicitation", "time-exceeded",
+		"ttl-zero-during-reassembly", "ttl-zero-during-transit", "unknown-header-type", "unknown-option",
+	}
+)
+
+// FirewallProtocol validates that a string is an IPv4 protocol accepted by
+// firewall rules, either by name, e.g. tcp_udp, or by number from 0 to 255.
+func FirewallProtocol() validator.String {
+	return stringValidator{
+		description: "a protocol name such as all, tcp, udp, tcp_udp or icmp, or a protocol number from 0 to 255",
+		valid: func(value string) bool {
+			return slices.Contains(firewallProtocols, value) || isProtocolNumber(value)
+		},
+	}
+}
+
+// FirewallProtocolV6 validates that a string is an IPv6 protocol accepted by
+// firewall rules, either by name, e.g. icmpv6, or by number from 0 to 255.
+func FirewallProtocolV6() validator.String {
+	return stringValidator{
+		description: "a protocol name such as all, tcp, udp, tcp_udp or icmpv6, or a protocol number from 0 to 255",
+		valid: func(value string) bool {
+			return slices.Contains(firewallProtocolsV6, value) || isProtocolNumber(value)
+		},
+	}
+}
+
+// ICMPTypeName validates that a string is an ICMP type name accepted by
+// firewall rules, e.g. echo-request.
+func ICMPTypeName() validator.String {
+	return stringValidator{
+		description: "an ICMP type name such as any, echo-request or destination-unreachable",
+		valid: func(value string) bool {
+			return slices.Contains(icmpTypeNames, value)
+		},
+	}
+}
+
+// ICMPv6TypeName validates that a string is an ICMPv6 type name accepted by
+// firewall rules, e.g. echo-request.
+func ICMPv6TypeName() validator.String {
+	return stringValidator{
+		description: "an ICMPv6 type name such as echo-request or neighbor-solicitation",
+		valid: func(value string) bool {
+			return slices.Contains(icmpV6TypeNames, value)
+		},
+	}
+}
+
+func isProtocolNumber(value string) bool {
+	n, err := strconv.Atoi(value)
+	return err == nil && n >= 0 && n <= 255 && strconv.Itoa(n) == value
+}
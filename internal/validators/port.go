@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// PortSpec validates that a string is a port, a port range or a comma
+// separated list of both as accepted by the Unifi Controller, e.g. 80,
+// 8000-8080 or 80,443,8000-8080.
+func PortSpec() validator.String {
+	return stringValidator{
+		description: "a port, a port range or a comma separated list of both, e.g. 80,443,8000-8080",
+		valid:       isPortSpec,
+	}
+}
+
+func isPortSpec(value string) bool {
+	_, err := ParsePortSpec(value)
+	return err == nil
+}
+
+// PortRange is a range of ports. First and Last are equal for a single port.
+type PortRange struct {
+	First int
+	Last  int
+}
+
+// ParsePortSpec parses a port specification as validated by PortSpec into
+// its ports and port ranges, in the order they are listed.
+func ParsePortSpec(value string) ([]PortRange, error) {
+	if value == "" {
+		return nil, fmt.Errorf("port specification must not be empty")
+	}
+
+	var ranges []PortRange
+	for _, part := range strings.Split(value, ",") {
+		start, end, isRange := strings.Cut(part, "-")
+		if !isRange {
+			end = start
+		}
+
+		first, ok := parsePort(start)
+		if !ok {
+			return nil, fmt.Errorf("%q is not a port between 1 and 65535", start)
+		}
+		last, ok := parsePort(end)
+		if !ok {
+			return nil, fmt.Errorf("%q is not a port between 1 and 65535", end)
+		}
+		if last < first {
+			return nil, fmt.Errorf("port range %q ends before it starts", part)
+		}
+
+		ranges = append(ranges, PortRange{First: first, Last: last})
+	}
+
+	return ranges, nil
+}
+
+func parsePort(value string) (int, bool) {
+	port, err := strconv.Atoi(value)
+	if err != nil || strings.HasPrefix(value, "+") || port < 1 || port > 65535 {
+		return 0, false
+	}
+
+	return port, true
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// TimeOfDay validates that a string is a time of day in 24-hour HH:MM
+// format, e.g. 08:00 or 17:30.
+func TimeOfDay() validator.String {
+	return stringValidator{
+		description: "a time of day in HH:MM format, e.g. 17:30",
+		valid: func(value string) bool {
+			_, err := time.Parse("15:04", value)
+			return err == nil && len(value) == len("15:04")
+		},
+	}
+}
+
+// Date validates that a string is a date in YYYY-MM-DD format, e.g.
+// 2025-09-01.
+func Date() validator.String {
+	return stringValidator{
+		description: "a date in YYYY-MM-DD format, e.g. 2025-09-01",
+		valid: func(value string) bool {
+			_, err := time.Parse(time.DateOnly, value)
+			return err == nil
+		},
+	}
+}
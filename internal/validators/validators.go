@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validators contains schema validators for values specific to the
+// Unifi Controller, such as addresses, port specifications and firewall
+// protocols. They are referenced from generate/provider-spec.json.
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = stringValidator{}
+
+// stringValidator validates a string attribute with a function.
+type stringValidator struct {
+	description string
+	valid       func(value string) bool
+}
+
+func (v stringValidator) Description(_ context.Context) string {
+	return "value must be " + v.description
+}
+
+func (v stringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if !v.valid(value) {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func validate(v validator.String, value types.String) bool {
+	resp := &validator.StringResponse{}
+	v.ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("test"),
+		ConfigValue: value,
+	}, resp)
+
+	return !resp.Diagnostics.HasError()
+}
+
+func TestStringValidators(t *testing.T) {
+	cases := []struct {
+		name      string
+		validator validator.String
+		valid     []string
+		invalid   []string
+	}{
+		{"IPAddress", IPAddress(), []string{"192.168.1.1", "fd00::1"}, []string{"", "192.168.1", "192.168.1.0/24"}},
+		{"IPv4Address", IPv4Address(), []string{"10.0.0.1"}, []string{"fd00::1", "10.0.0.256", "10.0.0.1 "}},
+		{"IPv6Address", IPv6Address(), []string{"fd00::1", "2001:db8::"}, []string{"10.0.0.1", "::ffff:10.0.0.1", "fd00::/64"}},
+		{"CIDR", CIDR(), []string{"10.0.0.0/8", "fd00::/64"}, []string{"10.0.0.1", "10.0.0.0/33"}},
+		{"IPv4CIDR", IPv4CIDR(), []string{"192.168.1.1/24", "10.0.0.0/8"}, []string{"10.0.0.0/33", "fd00::/64", "10.0.0.0"}},
+		{"IPv6CIDR", IPv6CIDR(), []string{"fd00::1/64"}, []string{"fd00::/129", "10.0.0.0/8"}},
+		{"IPv4AddressOrCIDR", IPv4AddressOrCIDR(), []string{"10.0.0.1", "10.0.0.0/8"}, []string{"fd00::1", "any"}},
+		{"IPv6AddressOrCIDR", IPv6AddressOrCIDR(), []string{"fd00::1", "fd00::/64"}, []string{"10.0.0.1", "10.0.0.0/8"}},
+		{"IPv4Netmask", IPv4Netmask(), []string{"255.255.255.0", "255.255.0.0"}, []string{"255.0.255.0", "24"}},
+		{"MACAddress", MACAddress(), []string{"00:11:22:aa:bb:cc", "00-11-22-AA-BB-CC"}, []string{"0011.22aa.bbcc", "00:11:22:aa:bb", "00:11:22:aa:bb:cc:dd:ee"}},
+		{"PortSpec", PortSpec(), []string{"80", "8000-8080", "80,443,8000-8080"}, []string{"", "0", "65536", "80-", "90-80", "80,,443", "http"}},
+		{"FirewallProtocol", FirewallProtocol(), []string{"tcp_udp", "icmp", "6", "255"}, []string{"tcp-udp", "256", "06", "icmpv6"}},
+		{"FirewallProtocolV6", FirewallProtocolV6(), []string{"icmpv6", "tcp"}, []string{"icmp"}},
+		{"ICMPTypeName", ICMPTypeName(), []string{"echo-request", "any"}, []string{"ping"}},
+		{"ICMPv6TypeName", ICMPv6TypeName(), []string{"neighbor-solicitation"}, []string{"any"}},
+		{"TimeOfDay", TimeOfDay(), []string{"00:00", "08:30", "23:59"}, []string{"8:30", "24:00", "08:60", "08:30:00"}},
+		{"Date", Date(), []string{"2025-09-01", "2024-02-29"}, []string{"2025-9-1", "2025-02-29", "01/09/2025"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			for _, value := range c.valid {
+				assert.True(t, validate(c.validator, types.StringValue(value)), "%q should be valid", value)
+			}
+			for _, value := range c.invalid {
+				assert.False(t, validate(c.validator, types.StringValue(value)), "%q should be invalid", value)
+			}
+
+			assert.True(t, validate(c.validator, types.StringNull()))
+			assert.True(t, validate(c.validator, types.StringUnknown()))
+		})
+	}
+}
@@ -0,0 +1,624 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Command mappinggen generates the conversions between the Terraform models
+// of resources and data sources and the API types of the SDK.
+//
+// The attribute types are read from generate/provider-spec.json, the
+// framework code generation spec. That spec is validated against a fixed
+// schema, so the field of the API type backing each attribute is annotated in
+// generate/mapping-spec.json. For each mapping, the generated code covers the
+// resource, the data source and the items of the plural data source, so they
+// can't drift apart. Attributes marked as manual are converted by hand in the
+// provider.
+//
+// Only the known values of a resource model are written to the API type, so
+// an update can overlay the plan on the object read from the controller
+// without resetting the fields left out of the configuration.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	internalPath = "github.com/zoullx/terraform-provider-unifi/internal/"
+	sdkPath      = "github.com/zoullx/unifi-go/unifi"
+)
+
+func main() {
+	specPath := flag.String("spec", "../generate/provider-spec.json", "path of the provider code generation spec")
+	mappingPath := flag.String("mapping", "../generate/mapping-spec.json", "path of the field mapping annotations")
+	output := flag.String("output", "../internal/provider", "directory to write the generated files to")
+	flag.Parse()
+
+	spec, err := readProviderSpec(*specPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	mappings, err := readMappingSpec(*mappingPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, m := range mappings.Mappings {
+		source, err := generate(spec, m)
+		if err != nil {
+			log.Fatalf("mapping %s: %s", m.Name, err)
+		}
+
+		filename := filepath.Join(*output, m.Name+"_mapping_gen.go")
+		if err := os.WriteFile(filename, source, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+type providerSpec struct {
+	DataSources []schemaSpec `json:"datasources"`
+	Resources   []schemaSpec `json:"resources"`
+}
+
+type schemaSpec struct {
+	Name   string `json:"name"`
+	Schema struct {
+		Attributes []attributeSpec `json:"attributes"`
+	} `json:"schema"`
+}
+
+type attributeSpec struct {
+	Name       string          `json:"name"`
+	Bool       *scalarSpec     `json:"bool"`
+	Int64      *scalarSpec     `json:"int64"`
+	String     *scalarSpec     `json:"string"`
+	List       *collectionSpec `json:"list"`
+	Set        *collectionSpec `json:"set"`
+	ListNested *struct {
+		NestedObject struct {
+			Attributes []attributeSpec `json:"attributes"`
+		} `json:"nested_object"`
+	} `json:"list_nested"`
+}
+
+type scalarSpec struct {
+	CustomType *customTypeSpec `json:"custom_type"`
+}
+
+type collectionSpec struct {
+	ElementType struct {
+		Bool   *scalarSpec `json:"bool"`
+		Int64  *scalarSpec `json:"int64"`
+		String *scalarSpec `json:"string"`
+	} `json:"element_type"`
+}
+
+type customTypeSpec struct {
+	Import struct {
+		Path string `json:"path"`
+	} `json:"import"`
+	Type      string `json:"type"`
+	ValueType string `json:"value_type"`
+}
+
+type mappingSpec struct {
+	Mappings []mapping `json:"mappings"`
+}
+
+// mapping annotates the attributes of a resource, its data source and the
+// items of its plural data source with the fields of an API type.
+type mapping struct {
+	// Name is used for the generated file and function names.
+	Name string `json:"name"`
+	// SdkType is the name of the API type in the SDK package.
+	SdkType string `json:"sdk_type"`
+	// APIType is the name of an API type of the provider package, used
+	// instead of SdkType for objects the SDK doesn't model.
+	APIType string `json:"api_type"`
+
+	Resource         string `json:"resource"`
+	DataSource       string `json:"data_source"`
+	PluralDataSource string `json:"plural_data_source"`
+
+	Attributes []attributeMapping `json:"attributes"`
+}
+
+type attributeMapping struct {
+	Name string `json:"name"`
+	// Field is the field of the API type holding the value. A list of
+	// strings can instead be stored in numbered fields, e.g. DNS1 to DNS4,
+	// set in Fields. Empty fields are left out of the list.
+	Field  string   `json:"field"`
+	Fields []string `json:"fields"`
+	// Normalize sends ValueNormalized() of a custom type to the API instead
+	// of the configured value. For sets of MAC addresses, the elements are
+	// normalized.
+	Normalize bool `json:"normalize"`
+	// Manual attributes are converted by hand.
+	Manual bool `json:"manual"`
+}
+
+func readProviderSpec(path string) (*providerSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec providerSpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return &spec, nil
+}
+
+func readMappingSpec(path string) (*mappingSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var spec mappingSpec
+	if err := decoder.Decode(&spec); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return &spec, nil
+}
+
+// target is a model the attributes are converted to or from.
+type target struct {
+	// function is the name of the generated function converting the API
+	// type to the model.
+	function string
+	pkg      string
+	// model is the type of the model, or empty for plural data source items,
+	// which are converted to a map of attribute values.
+	model      string
+	attributes map[string]attributeSpec
+}
+
+func generate(spec *providerSpec, m mapping) ([]byte, error) {
+	var targets []target
+
+	if m.Resource != "" {
+		s, err := findSchema(spec.Resources, m.Resource)
+		if err != nil {
+			return nil, fmt.Errorf("resource: %w", err)
+		}
+
+		targets = append(targets, target{
+			function:   "map" + camel(m.Resource) + "ResourceJson",
+			pkg:        "resource_" + m.Resource,
+			model:      camel(m.Resource) + "Model",
+			attributes: attributesByName(s.Schema.Attributes),
+		})
+	}
+
+	if m.DataSource != "" {
+		s, err := findSchema(spec.DataSources, m.DataSource)
+		if err != nil {
+			return nil, fmt.Errorf("data source: %w", err)
+		}
+
+		targets = append(targets, target{
+			function:   "map" + camel(m.DataSource) + "DataSourceJson",
+			pkg:        "datasource_" + m.DataSource,
+			model:      camel(m.DataSource) + "Model",
+			attributes: attributesByName(s.Schema.Attributes),
+		})
+	}
+
+	if m.PluralDataSource != "" {
+		s, err := findSchema(spec.DataSources, m.PluralDataSource)
+		if err != nil {
+			return nil, fmt.Errorf("plural data source: %w", err)
+		}
+
+		var items *attributeSpec
+		for _, a := range s.Schema.Attributes {
+			if a.Name == m.PluralDataSource && a.ListNested != nil {
+				items = &a
+			}
+		}
+		if items == nil {
+			return nil, fmt.Errorf("plural data source: no list nested attribute %s", m.PluralDataSource)
+		}
+
+		targets = append(targets, target{
+			function:   "map" + camel(m.PluralDataSource) + "DataSourceJson",
+			pkg:        "datasource_" + m.PluralDataSource,
+			attributes: attributesByName(items.ListNested.NestedObject.Attributes),
+		})
+	}
+
+	if err := checkAttributes(m, targets); err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		mapping: m,
+		imports: map[string]bool{
+			"github.com/hashicorp/terraform-plugin-framework/types": true,
+		},
+	}
+	if m.APIType == "" {
+		g.imports[sdkPath] = true
+	}
+
+	for _, t := range targets {
+		if t.model != "" {
+			g.imports[internalPath+t.pkg] = true
+		}
+
+		if err := g.writeFromJson(t); err != nil {
+			return nil, err
+		}
+
+		if strings.HasPrefix(t.pkg, "resource_") {
+			if err := g.writeToJson(t); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g.source()
+}
+
+func findSchema(schemas []schemaSpec, name string) (*schemaSpec, error) {
+	for _, s := range schemas {
+		if s.Name == name {
+			return &s, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s not found in the provider spec", name)
+}
+
+func attributesByName(attributes []attributeSpec) map[string]attributeSpec {
+	byName := make(map[string]attributeSpec, len(attributes))
+	for _, a := range attributes {
+		byName[a.Name] = a
+	}
+
+	return byName
+}
+
+// checkAttributes requires every attribute of the targets to be mapped or
+// marked as manual, so new attributes can't be forgotten.
+func checkAttributes(m mapping, targets []target) error {
+	mapped := map[string]bool{}
+	for _, a := range m.Attributes {
+		if mapped[a.Name] {
+			return fmt.Errorf("attribute %s is mapped more than once", a.Name)
+		}
+		mapped[a.Name] = true
+
+		if !a.Manual && a.Field == "" && len(a.Fields) == 0 {
+			return fmt.Errorf("attribute %s has no field and isn't manual", a.Name)
+		}
+
+		found := false
+		for _, t := range targets {
+			if _, ok := t.attributes[a.Name]; ok {
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("attribute %s isn't in any schema", a.Name)
+		}
+	}
+
+	for _, t := range targets {
+		for name := range t.attributes {
+			if !mapped[name] {
+				return fmt.Errorf("attribute %s of %s has no mapping", name, t.pkg)
+			}
+		}
+	}
+
+	return nil
+}
+
+type generator struct {
+	mapping mapping
+	imports map[string]bool
+	body    bytes.Buffer
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.body, format, args...)
+}
+
+// needsContext reports whether converting the attributes of t uses a
+// context and can return diagnostics, which is the case for collections.
+func (g *generator) needsContext(t target) bool {
+	for _, a := range g.mapping.Attributes {
+		spec, ok := t.attributes[a.Name]
+		if ok && !a.Manual && (spec.List != nil || spec.Set != nil) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// apiType returns the Go type of the API objects of the mapping.
+func (g *generator) apiType() string {
+	if g.mapping.APIType != "" {
+		return g.mapping.APIType
+	}
+	return "unifi." + g.mapping.SdkType
+}
+
+func (g *generator) writeFromJson(t target) error {
+	sdkType := g.apiType()
+	withContext := g.needsContext(t)
+
+	if t.model == "" {
+		g.imports["context"] = true
+		g.imports["github.com/hashicorp/terraform-plugin-framework/attr"] = true
+		g.imports["github.com/hashicorp/terraform-plugin-framework/diag"] = true
+
+		g.printf("// %s sets the mapped attributes of a %s item from json.\n", t.function, strings.TrimPrefix(t.pkg, "datasource_"))
+		g.printf("func %s(ctx context.Context, json %s, attributes map[string]attr.Value) diag.Diagnostics {\n", t.function, sdkType)
+		g.printf("var diags diag.Diagnostics\n\n")
+	} else if withContext {
+		g.imports["context"] = true
+		g.imports["github.com/hashicorp/terraform-plugin-framework/diag"] = true
+
+		g.printf("// %s sets the mapped attributes of model from json.\n", t.function)
+		g.printf("func %s(ctx context.Context, json %s, model *%s.%s) diag.Diagnostics {\n", t.function, sdkType, t.pkg, t.model)
+		g.printf("var diags diag.Diagnostics\n\n")
+	} else {
+		g.printf("// %s sets the mapped attributes of model from json.\n", t.function)
+		g.printf("func %s(json %s, model *%s.%s) {\n", t.function, sdkType, t.pkg, t.model)
+	}
+
+	for _, a := range g.mapping.Attributes {
+		spec, ok := t.attributes[a.Name]
+		if !ok || a.Manual {
+			continue
+		}
+
+		dst := "model." + camel(a.Name)
+		if t.model == "" {
+			dst = fmt.Sprintf("attributes[%q]", a.Name)
+		}
+
+		switch {
+		case spec.List != nil || spec.Set != nil:
+			value, err := g.collectionFromJson(a, spec)
+			if err != nil {
+				return err
+			}
+
+			variable := lowerCamel(a.Name) + "Value"
+			g.printf("%s, d := %s\n", variable, value)
+			g.printf("diags.Append(d...)\n")
+			g.printf("%s = %s\n", dst, variable)
+		default:
+			value, err := g.scalarFromJson(a, spec)
+			if err != nil {
+				return err
+			}
+
+			g.printf("%s = %s\n", dst, value)
+		}
+	}
+
+	if withContext || t.model == "" {
+		g.printf("\nreturn diags\n")
+	}
+	g.printf("}\n\n")
+
+	return nil
+}
+
+func (g *generator) scalarFromJson(a attributeMapping, spec attributeSpec) (string, error) {
+	if a.Field == "" {
+		return "", fmt.Errorf("attribute %s: a scalar needs a field", a.Name)
+	}
+
+	field := "json." + a.Field
+
+	switch {
+	case spec.String != nil && spec.String.CustomType != nil:
+		return g.customValue(spec.String.CustomType, field), nil
+	case spec.String != nil:
+		return "types.StringValue(" + field + ")", nil
+	case spec.Bool != nil:
+		return "types.BoolValue(" + field + ")", nil
+	case spec.Int64 != nil:
+		return "types.Int64Value(int64(" + field + "))", nil
+	}
+
+	return "", fmt.Errorf("attribute %s: unsupported type, mark it as manual", a.Name)
+}
+
+func (g *generator) collectionFromJson(a attributeMapping, spec attributeSpec) (string, error) {
+	elemType, err := g.elementType(a, spec)
+	if err != nil {
+		return "", err
+	}
+
+	function := "types.ListValueFrom"
+	if spec.Set != nil {
+		function = "types.SetValueFrom"
+	}
+
+	if len(a.Fields) > 0 {
+		fields := make([]string, len(a.Fields))
+		for i, f := range a.Fields {
+			fields[i] = "json." + f
+		}
+
+		return fmt.Sprintf("%s(ctx, %s, compactStrings(%s))", function, elemType, strings.Join(fields, ", ")), nil
+	}
+
+	return fmt.Sprintf("%s(ctx, %s, json.%s)", function, elemType, a.Field), nil
+}
+
+func (g *generator) elementType(a attributeMapping, spec attributeSpec) (string, error) {
+	collection := spec.List
+	if collection == nil {
+		collection = spec.Set
+	}
+
+	elem := collection.ElementType
+	if len(a.Fields) > 0 && elem.String == nil {
+		return "", fmt.Errorf("attribute %s: numbered fields need string elements", a.Name)
+	}
+
+	switch {
+	case elem.String != nil && elem.String.CustomType != nil:
+		g.imports[elem.String.CustomType.Import.Path] = true
+		return elem.String.CustomType.Type, nil
+	case elem.String != nil:
+		return "types.StringType", nil
+	case elem.Bool != nil:
+		return "types.BoolType", nil
+	case elem.Int64 != nil:
+		return "types.Int64Type", nil
+	}
+
+	return "", fmt.Errorf("attribute %s: unsupported element type, mark it as manual", a.Name)
+}
+
+// customValue returns the constructor call of a custom string type, which
+// is named after its value type, e.g. customtypes.NewIPPrefixValue.
+func (g *generator) customValue(customType *customTypeSpec, value string) string {
+	g.imports[customType.Import.Path] = true
+
+	pkg, name, _ := strings.Cut(customType.ValueType, ".")
+
+	return fmt.Sprintf("%s.New%sValue(%s)", pkg, name, value)
+}
+
+func (g *generator) writeToJson(t target) error {
+	function := strings.TrimSuffix(t.function, "Json") + "Model"
+	sdkType := g.apiType()
+	withContext := g.needsContext(t)
+
+	g.printf("// %s sets the mapped fields of json from the known values of model.\n", function)
+	if withContext {
+		g.printf("func %s(ctx context.Context, model %s.%s, json *%s) diag.Diagnostics {\n", function, t.pkg, t.model, sdkType)
+		g.printf("var diags diag.Diagnostics\n\n")
+	} else {
+		g.printf("func %s(model %s.%s, json *%s) {\n", function, t.pkg, t.model, sdkType)
+	}
+
+	for _, a := range g.mapping.Attributes {
+		spec, ok := t.attributes[a.Name]
+		if !ok || a.Manual {
+			continue
+		}
+
+		// Values that are null or unknown in the plan aren't configured, so
+		// the field keeps the value json already has, e.g. the one read from
+		// the controller before an update.
+		value := "model." + camel(a.Name)
+		g.printf("if !%s.IsNull() && !%s.IsUnknown() {\n", value, value)
+
+		switch {
+		case spec.List != nil || spec.Set != nil:
+			if len(a.Fields) > 0 {
+				variable := lowerCamel(a.Name)
+				g.printf("var %s []string\n", variable)
+				g.printf("diags.Append(%s.ElementsAs(ctx, &%s, false)...)\n", value, variable)
+				for i, f := range a.Fields {
+					g.printf("json.%s = stringAtIndex(%s, %d)\n", f, variable, i)
+				}
+				break
+			}
+
+			if a.Normalize {
+				if spec.Set == nil {
+					return fmt.Errorf("attribute %s: only sets can be normalized", a.Name)
+				}
+
+				variable := lowerCamel(a.Name)
+				g.printf("%s, d := normalizedMacAddresses(ctx, %s)\n", variable, value)
+				g.printf("diags.Append(d...)\n")
+				g.printf("json.%s = %s\n", a.Field, variable)
+			} else {
+				g.printf("diags.Append(%s.ElementsAs(ctx, &json.%s, false)...)\n", value, a.Field)
+			}
+		case a.Normalize:
+			if spec.String == nil || spec.String.CustomType == nil {
+				return fmt.Errorf("attribute %s: only custom string types can be normalized", a.Name)
+			}
+
+			g.printf("json.%s = %s.ValueNormalized()\n", a.Field, value)
+		case spec.String != nil:
+			g.printf("json.%s = %s.ValueString()\n", a.Field, value)
+		case spec.Bool != nil:
+			g.printf("json.%s = %s.ValueBool()\n", a.Field, value)
+		case spec.Int64 != nil:
+			g.printf("json.%s = int(%s.ValueInt64())\n", a.Field, value)
+		default:
+			return fmt.Errorf("attribute %s: unsupported type, mark it as manual", a.Name)
+		}
+
+		g.printf("}\n")
+	}
+
+	if withContext {
+		g.printf("\nreturn diags\n")
+	}
+	g.printf("}\n\n")
+
+	return nil
+}
+
+func (g *generator) source() ([]byte, error) {
+	imports := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		imports = append(imports, path)
+	}
+	sort.Strings(imports)
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by mappinggen from generate/mapping-spec.json DO NOT EDIT.\n\n")
+	b.WriteString("package provider\n\n")
+	b.WriteString("import (\n")
+	for _, path := range imports {
+		fmt.Fprintf(&b, "%q\n", path)
+	}
+	b.WriteString(")\n\n")
+	b.Write(bytes.TrimRight(g.body.Bytes(), "\n"))
+	b.WriteString("\n")
+
+	return format.Source(b.Bytes())
+}
+
+// camel converts an attribute name to the field name used by the framework
+// code generator, e.g. dhcp_v6_dns to DhcpV6Dns.
+func camel(name string) string {
+	parts := strings.Split(name, "_")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+
+	return strings.Join(parts, "")
+}
+
+func lowerCamel(name string) string {
+	c := camel(name)
+
+	return strings.ToLower(c[:1]) + c[1:]
+}
@@ -13,6 +13,9 @@ import (
 // Generate copyright headers
 //go:generate go run github.com/hashicorp/copywrite headers -d .. --config ../.copywrite.hcl
 
+// Generate the conversions between models and API types.
+//go:generate go run ./mappinggen -spec ../generate/provider-spec.json -mapping ../generate/mapping-spec.json -output ../internal/provider
+
 // Format Terraform code for use in documentation.
 // If you do not have Terraform installed, you can remove the formatting command, but it is suggested
 // to ensure the documentation is formatted properly.